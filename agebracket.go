@@ -0,0 +1,39 @@
+package kennitala
+
+import "time"
+
+// Bracket is a labelled range of ages in completed years, from Min to Max
+// inclusive. A negative Max leaves the bracket open ended.
+type Bracket struct {
+	Label string
+	Min   int
+	Max   int
+}
+
+// AgeBrackets are the brackets AgeBracket classifies ages into, checked in
+// order. Replace them to bucket ages differently.
+var AgeBrackets = []Bracket{
+	{Label: "0-17", Min: 0, Max: 17},
+	{Label: "18-29", Min: 18, Max: 29},
+	{Label: "30-49", Min: 30, Max: 49},
+	{Label: "50-64", Min: 50, Max: 64},
+	{Label: "65+", Min: 65, Max: -1},
+}
+
+// AgeBracket returns the label of the first of AgeBrackets the age at the
+// given time falls in. Only individuals have an age, so other kennitala
+// types return an error, as do ages outside every bracket.
+func (kennitala Kennitala) AgeBracket(at time.Time) (string, error) {
+	age, err := kennitala.AgeAt(at)
+	if err != nil {
+		return "", err
+	}
+
+	for _, bracket := range AgeBrackets {
+		if age >= bracket.Min && (bracket.Max < 0 || age <= bracket.Max) {
+			return bracket.Label, nil
+		}
+	}
+
+	return "", errNoAgeBracket()
+}
@@ -0,0 +1,48 @@
+package kennitala
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAgeBracketSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101002080" // 1 January 2000
+	tests := map[time.Time]string{
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC):   "0-17",
+		time.Date(2017, 12, 31, 0, 0, 0, 0, time.UTC): "0-17",
+		time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC):   "18-29",
+		time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC):   "30-49",
+		time.Date(2064, 6, 1, 0, 0, 0, 0, time.UTC):   "50-64",
+		time.Date(2065, 1, 1, 0, 0, 0, 0, time.UTC):   "65+",
+	}
+	for at, expected := range tests {
+		bracket, err := kennitala.AgeBracket(at)
+		if err != nil || bracket != expected {
+			t.Errorf("Test Fail: %s", at)
+		}
+	}
+}
+
+func TestAgeBracketCustomSuccess(t *testing.T) {
+	defer func(brackets []Bracket) { AgeBrackets = brackets }(AgeBrackets)
+	AgeBrackets = []Bracket{{Label: "minor", Min: 0, Max: 17}, {Label: "adult", Min: 18, Max: -1}}
+
+	var kennitala Kennitala = "0101303019"
+	bracket, err := kennitala.AgeBracket(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil || bracket != "adult" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestAgeBracketFail(t *testing.T) {
+	var kennitala Kennitala = "0101002080"
+	if _, err := kennitala.AgeBracket(time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrNoAgeBracket) {
+		t.Errorf("Test Fail")
+	}
+
+	var company Kennitala = "6204830369"
+	if _, err := company.AgeBracket(time.Now()); !errors.Is(err, ErrInvalidKennitalaFirstLetter) {
+		t.Errorf("Test Fail")
+	}
+}
@@ -0,0 +1,123 @@
+package kennitala
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"runtime"
+	"sync"
+)
+
+// ValidateBatch validates every input against kennitalaType using one worker
+// per CPU. The result has the same length as inputs, holding nil or the
+// validation failure for the input at the same index.
+func ValidateBatch(inputs []Kennitala, kennitalaType KennitalaType) []error {
+	return ValidateBatchWorkers(inputs, kennitalaType, runtime.GOMAXPROCS(0))
+}
+
+// ValidateBatchWorkers is like ValidateBatch but splits the work between the
+// given number of workers. Results are in input order regardless of workers.
+func ValidateBatchWorkers(inputs []Kennitala, kennitalaType KennitalaType, workers int) []error {
+	results := make([]error, len(inputs))
+	if len(inputs) == 0 {
+		return results
+	}
+
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(inputs) {
+		workers = len(inputs)
+	}
+	chunk := (len(inputs) + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	for start := 0; start < len(inputs); start += chunk {
+		end := start + chunk
+		if end > len(inputs) {
+			end = len(inputs)
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				results[i] = inputs[i].IsValidKennitala(kennitalaType)
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// ValidateBatchContext is like ValidateBatch but stops early when ctx is
+// done, returning ctx.Err() alongside the results. Inputs validated before
+// the cancellation have their results filled in; the rest are left nil, so
+// callers must check the returned error before trusting a nil result.
+func ValidateBatchContext(ctx context.Context, inputs []Kennitala, kennitalaType KennitalaType) ([]error, error) {
+	results := make([]error, len(inputs))
+	for i, kennitala := range inputs {
+		// Checking the context for every input is cheap next to validation
+		// and bounds how long a canceled batch keeps running
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		results[i] = kennitala.IsValidKennitala(kennitalaType)
+	}
+	return results, nil
+}
+
+// ValidateReader reads newline delimited kennitala from r, normalizes each
+// one as Normalize does and validates it against kennitalaType, and calls
+// onResult with the 1-based line number, the kennitala and the validation
+// result. Surrounding whitespace, including the CR of CRLF line endings, and
+// a byte order mark at the start of the file are ignored and blank lines are
+// skipped. Valid lines are reported in normalized form and invalid ones with
+// the kennitala as read. Lines are normalized into a fixed buffer, so the
+// only allocation per valid line is the kennitala passed to onResult. The
+// returned error is any error reading from r.
+func ValidateReader(r io.Reader, kennitalaType KennitalaType, onResult func(line int, kennitala Kennitala, err error)) error {
+	scanner := bufio.NewScanner(r)
+
+	var buf [11]byte
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		text := scanner.Bytes()
+		if line == 1 {
+			text = bytes.TrimPrefix(text, []byte(byteOrderMark))
+		}
+		text = bytes.TrimSpace(text)
+		if len(text) == 0 {
+			continue
+		}
+
+		value, err := normalizeBytes(text, &buf)
+		if err == nil {
+			err = IsValidBytes(value, kennitalaType)
+		}
+		if err != nil {
+			value = text
+		}
+		onResult(line, Kennitala(value), err)
+	}
+
+	return scanner.Err()
+}
+
+// Partition normalizes and validates each kennitala in ks against
+// kennitalaType, returning the valid ones in normalized form and the invalid
+// ones unchanged, both in their original order.
+func Partition(ks []Kennitala, kennitalaType KennitalaType) (valid []Kennitala, invalid []Kennitala) {
+	for _, kennitala := range ks {
+		if parsed, err := Parse(string(kennitala), kennitalaType); err == nil {
+			valid = append(valid, parsed)
+		} else {
+			invalid = append(invalid, kennitala)
+		}
+	}
+	return valid, invalid
+}
@@ -0,0 +1,188 @@
+package kennitala
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestValidateBatchSuccess(t *testing.T) {
+	inputs := []Kennitala{"0101303019", "0101303029", "6204830369", "0101"}
+	results := ValidateBatch(inputs, KennitalaIndividual)
+	if len(results) != 4 ||
+		results[0] != nil ||
+		!errors.Is(results[1], ErrInvalidKennitalaCheckDigit) ||
+		!errors.Is(results[2], ErrInvalidKennitalaFirstLetter) ||
+		!errors.Is(results[3], ErrInvalidKennitalaLength) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateBatchWorkersOrderSuccess(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	inputs := make([]Kennitala, 1001)
+	for i := range inputs {
+		inputs[i] = RandomIndividual(r)
+		if i%3 == 0 {
+			inputs[i] = inputs[i][:9] + "1"
+		}
+	}
+
+	for _, workers := range []int{0, 1, 7, 2000} {
+		results := ValidateBatchWorkers(inputs, KennitalaIndividual, workers)
+		for i, err := range results {
+			if !reflect.DeepEqual(err, inputs[i].IsValidKennitala(KennitalaIndividual)) {
+				t.Errorf("Test Fail: %d workers, index %d", workers, i)
+			}
+		}
+	}
+}
+
+func TestValidateBatchEmptySuccess(t *testing.T) {
+	if results := ValidateBatch(nil, KennitalaIndividual); len(results) != 0 {
+		t.Errorf("Test Fail")
+	}
+}
+
+type readerResult struct {
+	line      int
+	kennitala Kennitala
+	err       error
+}
+
+func TestValidateReaderSuccess(t *testing.T) {
+	input := "0101303019\r\n\n  010130-3019  \r\n0101303029\n\t\n6204830369"
+
+	var results []readerResult
+	err := ValidateReader(strings.NewReader(input), KennitalaIndividual, func(line int, kennitala Kennitala, err error) {
+		results = append(results, readerResult{line, kennitala, err})
+	})
+
+	if err != nil || len(results) != 4 {
+		t.Fatalf("Test Fail")
+	}
+	if results[0] != (readerResult{1, "0101303019", nil}) ||
+		results[1] != (readerResult{3, "0101303019", nil}) ||
+		results[2].line != 4 || results[2].kennitala != "0101303029" || !errors.Is(results[2].err, ErrInvalidKennitalaCheckDigit) ||
+		results[3].line != 6 || !errors.Is(results[3].err, ErrInvalidKennitalaFirstLetter) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateReaderNormalizesSuccess(t *testing.T) {
+	input := "\uFEFF0101303019\n010130 3019\n010130\u00a03019\t\n"
+
+	var results []readerResult
+	err := ValidateReader(strings.NewReader(input), KennitalaIndividual, func(line int, kennitala Kennitala, err error) {
+		results = append(results, readerResult{line, kennitala, err})
+	})
+
+	if err != nil || len(results) != 3 {
+		t.Fatalf("Test Fail")
+	}
+	for i, result := range results {
+		if result != (readerResult{i + 1, "0101303019", nil}) {
+			t.Errorf("Test Fail: %d", i+1)
+		}
+	}
+}
+
+func TestValidateReaderNormalizeFail(t *testing.T) {
+	input := "010130 30 19 1\n01013O3019\n"
+
+	var results []readerResult
+	err := ValidateReader(strings.NewReader(input), KennitalaIndividual, func(line int, kennitala Kennitala, err error) {
+		results = append(results, readerResult{line, kennitala, err})
+	})
+
+	if err != nil || len(results) != 2 ||
+		results[0].kennitala != "010130 30 19 1" || !errors.Is(results[0].err, ErrInvalidKennitalaLength) ||
+		results[1].kennitala != "01013O3019" || !errors.Is(results[1].err, ErrInvalidKennitalaNonNumeric) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateReaderAllocsSuccess(t *testing.T) {
+	countAllocs := func(lines int) float64 {
+		input := strings.Repeat("010130-3019\r\n", lines)
+		return testing.AllocsPerRun(20, func() {
+			_ = ValidateReader(strings.NewReader(input), KennitalaIndividual, func(int, Kennitala, error) {})
+		})
+	}
+
+	// One allocation per line, for the kennitala passed to onResult
+	if perLine := countAllocs(101) - countAllocs(1); perLine > 100 {
+		t.Errorf("Test Fail: %v allocations for 100 lines", perLine)
+	}
+}
+
+func TestValidateReaderErrorFail(t *testing.T) {
+	err := ValidateReader(iotest.ErrReader(io.ErrUnexpectedEOF), KennitalaIndividual, func(int, Kennitala, error) {})
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateBatchContextSuccess(t *testing.T) {
+	inputs := []Kennitala{"0101303019", "0101303029"}
+	results, err := ValidateBatchContext(context.Background(), inputs, KennitalaIndividual)
+	if err != nil || len(results) != 2 || results[0] != nil || !errors.Is(results[1], ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateBatchContextCanceledFail(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	inputs := []Kennitala{"0101303029", "0101303029"}
+	results, err := ValidateBatchContext(ctx, inputs, KennitalaIndividual)
+	if !errors.Is(err, context.Canceled) || len(results) != 2 || results[0] != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+type cancelAfter struct {
+	context.Context
+	calls, limit int
+}
+
+func (ctx *cancelAfter) Err() error {
+	ctx.calls++
+	if ctx.calls > ctx.limit {
+		return context.Canceled
+	}
+	return nil
+}
+
+func TestValidateBatchContextPartialFail(t *testing.T) {
+	ctx := &cancelAfter{Context: context.Background(), limit: 1}
+	inputs := []Kennitala{"0101303029", "0101303029", "0101303029"}
+	results, err := ValidateBatchContext(ctx, inputs, KennitalaIndividual)
+	if !errors.Is(err, context.Canceled) || results[0] == nil || results[1] != nil || results[2] != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestPartitionSuccess(t *testing.T) {
+	ks := []Kennitala{"010130-3019", "0101303029", "6204830369", " 0101303019", "abc"}
+	valid, invalid := Partition(ks, KennitalaIndividual)
+	if !reflect.DeepEqual(valid, []Kennitala{"0101303019", "0101303019"}) {
+		t.Errorf("Test Fail: %v", valid)
+	}
+	if !reflect.DeepEqual(invalid, []Kennitala{"0101303029", "6204830369", "abc"}) {
+		t.Errorf("Test Fail: %v", invalid)
+	}
+}
+
+func TestPartitionEmptySuccess(t *testing.T) {
+	valid, invalid := Partition(nil, KennitalaAllTypes)
+	if len(valid) != 0 || len(invalid) != 0 {
+		t.Errorf("Test Fail")
+	}
+}
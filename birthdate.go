@@ -0,0 +1,319 @@
+package kennitala
+
+import (
+	"time"
+
+	utils "github.com/noona-hq/kennitala/utils"
+)
+
+// Birthdate returns the date encoded in the kennitala at midnight UTC. For
+// companies the +40 day offset is removed, giving the registration date.
+// Iceland keeps UTC all year, so this is also midnight in Iceland; use
+// BirthdateIn for midnight in another time zone. Like IsValidKennitala it
+// accepts the dashed form, as do the other methods decoding the date.
+func (kennitala Kennitala) Birthdate() (time.Time, error) {
+	kennitala = undash(kennitala)
+	if len(kennitala) != 10 {
+		return time.Time{}, errInvalidKennitalaLength()
+	}
+	return parseBirthdate(kennitala)
+}
+
+// BirthdateIn is like Birthdate but returns midnight of the date in loc, or
+// in UTC if loc is nil. The calendar date is the same in every location,
+// only the instant differs.
+func (kennitala Kennitala) BirthdateIn(loc *time.Location) (time.Time, error) {
+	birthdate, err := kennitala.Birthdate()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	year, month, day := birthdate.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, loc), nil
+}
+
+// Weekday returns the day of the week of the date returned by Birthdate.
+// Kerfiskennitala do not encode a calendar date and return
+// ErrInvalidKennitalaDate.
+func (kennitala Kennitala) Weekday() (time.Weekday, error) {
+	birthdate, err := kennitala.Birthdate()
+	if err != nil {
+		return 0, err
+	}
+	return birthdate.Weekday(), nil
+}
+
+// BirthISOWeek returns the ISO 8601 year and week of the birthdate, as
+// time.Time.ISOWeek does, so early January birthdays may fall in the last
+// week of the previous year. Only individuals have a birthdate, so other
+// kennitala types return an error.
+func (kennitala Kennitala) BirthISOWeek() (year int, week int, err error) {
+	if err := kennitala.IsPerson(); err != nil {
+		return 0, 0, err
+	}
+
+	year, month, day, err := dateFields(undash(kennitala))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	year, week = time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return year, week, nil
+}
+
+// Century returns 1800, 1900 or 2000 depending on the century digit in the
+// last position, without decoding the rest of the date.
+func (kennitala Kennitala) Century() (int, error) {
+	kennitala = undash(kennitala)
+	if len(kennitala) != 10 {
+		return 0, errInvalidKennitalaLength()
+	}
+	return century(kennitala)
+}
+
+// BirthYear returns the four digit year from the century digit and the two
+// digit year, without checking the rest of the date.
+func (kennitala Kennitala) BirthYear() (int, error) {
+	century, err := kennitala.Century()
+	if err != nil {
+		return 0, err
+	}
+
+	year, ok := twoDigits(kennitala, 4)
+	if !ok {
+		return 0, errInvalidKennitalaNonNumeric()
+	}
+	return century + year, nil
+}
+
+// century returns the century of the year from the century digit
+// in the last position: 8 for the 1800s, 9 for the 1900s and 0 for the
+// 2000s, so a year of 00 with century digit 0 is 2000 and 99 is 2099. The
+// scheme has no digit for the 2100s yet, so every other digit is rejected
+// until Registers Iceland decides how to extend it.
+func century(kennitala Kennitala) (int, error) {
+	switch kennitala[9] {
+	case '8':
+		return 1800, nil
+	case '9':
+		return 1900, nil
+	case '0':
+		return 2000, nil
+	}
+	return 0, errInvalidKennitalaCentury()
+}
+
+func parseBirthdate(kennitala Kennitala) (time.Time, error) {
+	year, month, day, err := dateFields(kennitala)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), nil
+}
+
+// BirthdateString returns the encoded date in the ISO 8601 form YYYY-MM-DD.
+// The date is validated against the calendar like Birthdate, but without
+// constructing a time.Time.
+func (kennitala Kennitala) BirthdateString() (string, error) {
+	kennitala = undash(kennitala)
+	if len(kennitala) != 10 {
+		return "", errInvalidKennitalaLength()
+	}
+
+	year, month, day, err := dateFields(kennitala)
+	if err != nil {
+		return "", err
+	}
+
+	date := [10]byte{
+		byte('0' + year/1000), byte('0' + year/100%10), byte('0' + year/10%10), byte('0' + year%10),
+		'-', byte('0' + month/10), byte('0' + month%10),
+		'-', byte('0' + day/10), byte('0' + day%10),
+	}
+	return string(date[:]), nil
+}
+
+// MonthDay returns the month and day encoded in the kennitala, with the
+// company day offset removed, without looking at the year or century. As
+// the year is unknown, 29 February is always accepted.
+func (kennitala Kennitala) MonthDay() (month time.Month, day int, err error) {
+	kennitala = undash(kennitala)
+	if len(kennitala) != 10 {
+		return 0, 0, errInvalidKennitalaLength()
+	}
+
+	// 2000 is a leap year, so 29 February is accepted
+	m, day, err := monthAndDay(kennitala, 2000)
+	if err != nil {
+		return 0, 0, err
+	}
+	return time.Month(m), day, nil
+}
+
+// dateFields decodes and validates the encoded date arithmetically, without
+// going through time.Parse.
+func dateFields(kennitala Kennitala) (year int, month int, day int, err error) {
+	century, err := century(kennitala)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	year, ok := twoDigits(kennitala, 4)
+	if !ok {
+		return 0, 0, 0, errInvalidKennitalaDate()
+	}
+	year += century
+
+	month, day, err = monthAndDay(kennitala, year)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return year, month, day, nil
+}
+
+// monthAndDay decodes the month and day, with the company day offset
+// removed, and checks that they form a date in the given year.
+func monthAndDay(kennitala Kennitala, year int) (month int, day int, err error) {
+	day, okDay := twoDigits(kennitala, 0)
+	month, okMonth := twoDigits(kennitala, 2)
+	if !okDay || !okMonth {
+		return 0, 0, errInvalidKennitalaDate()
+	}
+	if first := kennitala[0]; first >= '4' && first <= '7' {
+		// Kennitala for companies encode the registration day as day + 40
+		day -= 40
+	}
+
+	if month < 1 || month > 12 {
+		return 0, 0, errInvalidKennitalaMonth()
+	}
+	if day < 1 || day > daysInMonth(year, month) {
+		return 0, 0, errInvalidKennitalaDay()
+	}
+	return month, day, nil
+}
+
+func twoDigits(kennitala Kennitala, i int) (int, bool) {
+	tens, okTens := utils.DigitAt(string(kennitala), i)
+	ones, okOnes := utils.DigitAt(string(kennitala), i+1)
+	return int(tens)*10 + int(ones), okTens && okOnes
+}
+
+var monthDays = [13]int{0, 31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+func daysInMonth(year int, month int) int {
+	if month == 2 && isLeapYear(year) {
+		return 29
+	}
+	return monthDays[month]
+}
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// SameBirthdate reports whether the two kennitala encode the same date of
+// birth, century included. Both must be valid individual kennitala.
+func (kennitala Kennitala) SameBirthdate(other Kennitala) (bool, error) {
+	if err := kennitala.IsPerson(); err != nil {
+		return false, err
+	}
+	if err := other.IsPerson(); err != nil {
+		return false, err
+	}
+
+	year, month, day, err := dateFields(undash(kennitala))
+	if err != nil {
+		return false, err
+	}
+	otherYear, otherMonth, otherDay, err := dateFields(undash(other))
+	if err != nil {
+		return false, err
+	}
+
+	return year == otherYear && month == otherMonth && day == otherDay, nil
+}
+
+// Age returns the age in completed years of the individual the kennitala
+// belongs to.
+func (kennitala Kennitala) Age() (int, error) {
+	return kennitala.AgeAt(time.Now())
+}
+
+// AgeAt returns the age in completed years at the given time. Age is only
+// meaningful for individuals, so other kennitala types return an error.
+func (kennitala Kennitala) AgeAt(t time.Time) (int, error) {
+	if err := kennitala.IsPerson(); err != nil {
+		return 0, err
+	}
+
+	birthdate, err := kennitala.Birthdate()
+	if err != nil {
+		return 0, err
+	}
+
+	year, month, day := t.Date()
+	age := year - birthdate.Year()
+	if month < birthdate.Month() || (month == birthdate.Month() && day < birthdate.Day()) {
+		age--
+	}
+
+	return age, nil
+}
+
+// AdultAge is the age of majority in Iceland.
+const AdultAge = 18
+
+// IsAdult reports whether the individual is at least AdultAge years old at
+// the given time. See IsAtLeast.
+func (kennitala Kennitala) IsAdult(at time.Time) (bool, error) {
+	return kennitala.IsAtLeast(AdultAge, at)
+}
+
+// IsAtLeast reports whether the individual is at least years old at the
+// given time, counting the birthday itself. Only individuals have an age, so
+// other kennitala types return an error.
+func (kennitala Kennitala) IsAtLeast(years int, at time.Time) (bool, error) {
+	age, err := kennitala.AgeAt(at)
+	if err != nil {
+		return false, err
+	}
+	return age >= years, nil
+}
+
+// DaysUntilBirthday returns the number of days from the calendar date of
+// from until the next birthday, which is 0 on the birthday itself. Birthdays
+// on 29 February are celebrated on 28 February in years that are not leap
+// years. Only individuals have birthdays, so other kennitala types return an
+// error.
+func (kennitala Kennitala) DaysUntilBirthday(from time.Time) (int, error) {
+	if err := kennitala.IsPerson(); err != nil {
+		return 0, err
+	}
+
+	birthdate, err := kennitala.Birthdate()
+	if err != nil {
+		return 0, err
+	}
+
+	year, month, day := from.Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	next := birthdayIn(birthdate, year)
+	if next.Before(today) {
+		next = birthdayIn(birthdate, year+1)
+	}
+
+	return int(next.Sub(today).Hours() / 24), nil
+}
+
+func birthdayIn(birthdate time.Time, year int) time.Time {
+	month, day := birthdate.Month(), birthdate.Day()
+	if month == time.February && day == 29 && !isLeapYear(year) {
+		day = 28
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,464 @@
+package kennitala
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBirthdateIndividualSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	birthdate, err := kennitala.Birthdate()
+	if err != nil || !birthdate.Equal(time.Date(1930, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestBirthdateCompanySuccess(t *testing.T) {
+	var kennitala Kennitala = "6204830369" // Marel hf.
+	birthdate, err := kennitala.Birthdate()
+	if err != nil || !birthdate.Equal(time.Date(1983, time.April, 22, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestBirthdateInvalidDateFail(t *testing.T) {
+	var kennitala Kennitala = "3202303019"
+	_, err := kennitala.Birthdate()
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaDate) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestBirthdateInvalidCenturyFail(t *testing.T) {
+	var kennitala Kennitala = "0101303011"
+	_, err := kennitala.Birthdate()
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaCentury) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestBirthdateNineteenthCenturySuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303018"
+	birthdate, err := kennitala.Birthdate()
+	if err != nil || birthdate.Year() != 1830 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestAgeAtBirthdaySuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	age, err := kennitala.AgeAt(time.Date(2030, time.January, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil || age != 100 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestAgeAtDayBeforeBirthdaySuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	age, err := kennitala.AgeAt(time.Date(2029, time.December, 31, 12, 0, 0, 0, time.UTC))
+	if err != nil || age != 99 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestAgeCompanyFail(t *testing.T) {
+	var kennitala Kennitala = "6204830369" // Marel hf.
+	_, err := kennitala.Age()
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaFirstLetter) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestCenturyBoundariesSuccess(t *testing.T) {
+	for kennitala, year := range map[Kennitala]int{"0101002080": 2000, "3112992040": 2099} {
+		if err := kennitala.IsPerson(); err != nil {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+		if birthdate, _ := kennitala.Birthdate(); birthdate.Year() != year {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestLeapYearSuccess(t *testing.T) {
+	var kennitala Kennitala = "2902002020" // 2000 is a leap year
+	err := kennitala.IsPerson()
+	if err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestNonLeapYearFail(t *testing.T) {
+	for _, kennitala := range []Kennitala{
+		"2902012090", // 2001 is not a leap year
+		"2902002029", // 1900 is not a leap year
+	} {
+		err := kennitala.IsPerson()
+		if err == nil || !errors.Is(err, ErrInvalidKennitalaDate) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestCenturySuccess(t *testing.T) {
+	tests := map[Kennitala]int{"0101303018": 1800, "0101303019": 1900, "0101002080": 2000}
+	for kennitala, expected := range tests {
+		century, err := kennitala.Century()
+		if err != nil || century != expected {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestCenturyInvalidFail(t *testing.T) {
+	var kennitala Kennitala = "0101303017"
+	_, err := kennitala.Century()
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaCentury) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestBirthdateStringSuccess(t *testing.T) {
+	tests := map[Kennitala]string{
+		"0101303019": "1930-01-01",
+		"6204830369": "1983-04-22",
+		"2902002020": "2000-02-29",
+		"0101303018": "1830-01-01",
+	}
+	for kennitala, expected := range tests {
+		birthdate, err := kennitala.BirthdateString()
+		if err != nil || birthdate != expected {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestBirthdateStringInvalidFail(t *testing.T) {
+	tests := map[Kennitala]error{
+		"2902012090": ErrInvalidKennitalaDate,
+		"3213303019": ErrInvalidKennitalaDate,
+		"0101303017": ErrInvalidKennitalaCentury,
+		"010130301":  ErrInvalidKennitalaLength,
+	}
+	for kennitala, expected := range tests {
+		_, err := kennitala.BirthdateString()
+		if err == nil || !errors.Is(err, expected) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestDaysUntilBirthdaySuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	tests := map[time.Time]int{
+		time.Date(2024, time.January, 1, 23, 0, 0, 0, time.UTC):  0,
+		time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC): 1,
+		time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC):   364,
+		time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC):   365,
+	}
+	for from, expected := range tests {
+		days, err := kennitala.DaysUntilBirthday(from)
+		if err != nil || days != expected {
+			t.Errorf("Test Fail: %v", from)
+		}
+	}
+}
+
+func TestDaysUntilBirthdayLeapDaySuccess(t *testing.T) {
+	var kennitala Kennitala = "2902002020" // 2000-02-29
+	tests := map[time.Time]int{
+		time.Date(2001, time.February, 27, 0, 0, 0, 0, time.UTC): 1,
+		time.Date(2001, time.February, 28, 0, 0, 0, 0, time.UTC): 0,
+		time.Date(2001, time.March, 1, 0, 0, 0, 0, time.UTC):     364,
+		time.Date(2004, time.February, 29, 0, 0, 0, 0, time.UTC): 0,
+		time.Date(2003, time.March, 1, 0, 0, 0, 0, time.UTC):     365,
+	}
+	for from, expected := range tests {
+		days, err := kennitala.DaysUntilBirthday(from)
+		if err != nil || days != expected {
+			t.Errorf("Test Fail: %v", from)
+		}
+	}
+}
+
+func TestDaysUntilBirthdayCompanyFail(t *testing.T) {
+	var kennitala Kennitala = "6204830369" // Marel hf.
+	_, err := kennitala.DaysUntilBirthday(time.Now())
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaFirstLetter) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestMonthDaySuccess(t *testing.T) {
+	var kennitala Kennitala = "6204830369" // Marel hf.
+	month, day, err := kennitala.MonthDay()
+	if err != nil || month != time.April || day != 22 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestMonthDayLeapDaySuccess(t *testing.T) {
+	// 29 February 1900 is not a date, but it is a valid month and day
+	var kennitala Kennitala = "2902002029"
+	month, day, err := kennitala.MonthDay()
+	if err != nil || month != time.February || day != 29 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestMonthDayFail(t *testing.T) {
+	for _, kennitala := range []Kennitala{"3002002029", "0113303019", "7212231089", "0a01303019"} {
+		if _, _, err := kennitala.MonthDay(); !errors.Is(err, ErrInvalidKennitalaDate) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+	var kennitala Kennitala = "010130"
+	if _, _, err := kennitala.MonthDay(); !errors.Is(err, ErrInvalidKennitalaLength) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestWeekdaySuccess(t *testing.T) {
+	tests := map[Kennitala]time.Weekday{
+		"0101303019": time.Wednesday, // 1 January 1930
+		"6204830369": time.Friday,    // 22 April 1983
+		"2902002020": time.Tuesday,   // 29 February 2000
+		"0101303018": time.Friday,    // 1 January 1830
+	}
+	for kennitala, expected := range tests {
+		weekday, err := kennitala.Weekday()
+		if err != nil || weekday != expected {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestWeekdaySystemFail(t *testing.T) {
+	var kennitala Kennitala = "8101011059"
+	if _, err := kennitala.Weekday(); !errors.Is(err, ErrInvalidKennitalaDate) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestIsAdultSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101002080" // 1 January 2000
+	adult, err := kennitala.IsAdult(time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil || !adult {
+		t.Errorf("Test Fail")
+	}
+	adult, err = kennitala.IsAdult(time.Date(2017, time.December, 31, 23, 59, 0, 0, time.UTC))
+	if err != nil || adult {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestIsAtLeastSuccess(t *testing.T) {
+	var kennitala Kennitala = "2902002020" // 29 February 2000
+	at := time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)
+	if ok, err := kennitala.IsAtLeast(20, at); err != nil || !ok {
+		t.Errorf("Test Fail")
+	}
+	if ok, err := kennitala.IsAtLeast(21, at); err != nil || ok {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestIsAdultCompanyFail(t *testing.T) {
+	var kennitala Kennitala = "6204830369"
+	if _, err := kennitala.IsAdult(time.Now()); err == nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestBirthISOWeekSuccess(t *testing.T) {
+	tests := map[Kennitala][2]int{
+		"0101303019":  {1930, 1},  // Wednesday 1 January 1930
+		"010199-2049": {1998, 53}, // Friday 1 January 1999
+		"3112982089":  {1998, 53}, // Thursday 31 December 1998
+		"0101042030":  {2004, 1},  // Thursday 1 January 2004
+	}
+	for kennitala, expected := range tests {
+		year, week, err := kennitala.BirthISOWeek()
+		if err != nil || year != expected[0] || week != expected[1] {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestBirthISOWeekFail(t *testing.T) {
+	for _, kennitala := range []Kennitala{"6204830369", "8101011059", "0101303029"} {
+		if _, _, err := kennitala.BirthISOWeek(); err == nil {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestBirthdateInSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	birthdate, err := kennitala.BirthdateIn(loc)
+	if err != nil || birthdate.Location() != loc {
+		t.Fatalf("Test Fail")
+	}
+	if year, month, day := birthdate.Date(); year != 1930 || month != time.January || day != 1 || birthdate.Hour() != 0 {
+		t.Errorf("Test Fail")
+	}
+	if !birthdate.Equal(time.Date(1930, time.January, 1, 5, 0, 0, 0, time.UTC)) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestBirthdateInNilLocationSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	birthdate, err := kennitala.BirthdateIn(nil)
+	expected, _ := kennitala.Birthdate()
+	if err != nil || !birthdate.Equal(expected) || birthdate.Location() != time.UTC {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestBirthdateInFail(t *testing.T) {
+	var kennitala Kennitala = "3102303019"
+	if _, err := kennitala.BirthdateIn(time.UTC); !errors.Is(err, ErrInvalidKennitalaDate) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestBirthYearSuccess(t *testing.T) {
+	tests := map[Kennitala]int{
+		"0101303019": 1930,
+		"0101303018": 1830,
+		"3112992040": 2099,
+		"6204830369": 1983,
+		"3102303019": 1930,
+	}
+	for kennitala, expected := range tests {
+		if year, err := kennitala.BirthYear(); err != nil || year != expected {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestBirthYearFail(t *testing.T) {
+	tests := map[Kennitala]error{
+		"0101303011": ErrInvalidKennitalaCentury,
+		"01013":      ErrInvalidKennitalaLength,
+		"0101x03019": ErrInvalidKennitalaNonNumeric,
+	}
+	for kennitala, expected := range tests {
+		if _, err := kennitala.BirthYear(); !errors.Is(err, expected) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestLeapYearRulesSuccess(t *testing.T) {
+	tests := map[int]bool{1896: true, 1900: false, 2000: true, 2024: true, 2099: false, 2100: false}
+	for year, leap := range tests {
+		if isLeapYear(year) != leap || (daysInMonth(year, 2) == 29) != leap {
+			t.Errorf("Test Fail: %d", year)
+		}
+	}
+}
+
+func TestValidateBirthdateLeapDaySuccess(t *testing.T) {
+	tests := map[Kennitala]error{
+		"2902002020": nil,                    // 2000 is a leap year
+		"2902002029": ErrInvalidKennitalaDay, // 1900 is not
+		"2902963078": nil,                    // 1896 is
+		"6902002020": nil,                    // company on 29 February 2000
+	}
+	for kennitala, expected := range tests {
+		if err := validateBirthdateAndCentury(kennitala, false); !errors.Is(err, expected) || (expected == nil && err != nil) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func BenchmarkValidateBirthdateAndCentury(b *testing.B) {
+	var kennitala Kennitala = "0101303019"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = validateBirthdateAndCentury(kennitala, true)
+	}
+}
+
+// BenchmarkValidateBirthdateTimeParse measures validating the same date with
+// time.Parse, as validateBirthdateAndCentury used to, for comparison.
+func BenchmarkValidateBirthdateTimeParse(b *testing.B) {
+	var kennitala Kennitala = "0101303019"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		date := fmt.Sprintf("%s%s%d%s", kennitala[0:2], kennitala[2:4], 19, kennitala[4:6])
+		_, _ = time.Parse("02012006", date)
+	}
+}
+
+func BenchmarkBirthdate(b *testing.B) {
+	var kennitala Kennitala = "0101303019"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = kennitala.Birthdate()
+	}
+}
+
+func TestBirthdateMethodsDashedSuccess(t *testing.T) {
+	var kennitala Kennitala = "010130-3019"
+	at := time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	if birthdate, err := kennitala.Birthdate(); err != nil || !birthdate.Equal(time.Date(1930, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Test Fail: Birthdate")
+	}
+	if weekday, err := kennitala.Weekday(); err != nil || weekday != time.Wednesday {
+		t.Errorf("Test Fail: Weekday")
+	}
+	if century, err := kennitala.Century(); err != nil || century != 1900 {
+		t.Errorf("Test Fail: Century")
+	}
+	if date, err := kennitala.BirthdateString(); err != nil || date != "1930-01-01" {
+		t.Errorf("Test Fail: BirthdateString")
+	}
+	if month, day, err := kennitala.MonthDay(); err != nil || month != time.January || day != 1 {
+		t.Errorf("Test Fail: MonthDay")
+	}
+	if age, err := kennitala.AgeAt(at); err != nil || age != 90 {
+		t.Errorf("Test Fail: AgeAt")
+	}
+	if _, err := kennitala.Age(); err != nil {
+		t.Errorf("Test Fail: Age")
+	}
+	if adult, err := kennitala.IsAdult(at); err != nil || !adult {
+		t.Errorf("Test Fail: IsAdult")
+	}
+	if days, err := kennitala.DaysUntilBirthday(at); err != nil || days != 1 {
+		t.Errorf("Test Fail: DaysUntilBirthday")
+	}
+	if bracket, err := kennitala.AgeBracket(at); err != nil || bracket != "65+" {
+		t.Errorf("Test Fail: AgeBracket")
+	}
+}
+
+func TestSameBirthdateSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	if same, err := kennitala.SameBirthdate("010130-1079"); err != nil || !same {
+		t.Errorf("Test Fail")
+	}
+	// Same digits, different century
+	if same, err := kennitala.SameBirthdate("0101303018"); err != nil || same {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestSameBirthdateFail(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	for _, other := range []Kennitala{"6204830369", "0101303029", "8101011059"} {
+		if _, err := kennitala.SameBirthdate(other); err == nil {
+			t.Errorf("Test Fail: %s", other)
+		}
+		if _, err := other.SameBirthdate(kennitala); err == nil {
+			t.Errorf("Test Fail: %s", other)
+		}
+	}
+}
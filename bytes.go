@@ -0,0 +1,22 @@
+package kennitala
+
+import "unsafe"
+
+// IsValidBytes validates b like IsValidKennitala without first converting it
+// to a string, which would allocate. b is only read during the call: the
+// input recorded in a returned ValidationError, and the kennitala passed to
+// OnValidation, are copies.
+func IsValidBytes(b []byte, kennitalaType KennitalaType) error {
+	// The string aliases b, so it must not outlive this call
+	kennitala := Kennitala(*(*string)(unsafe.Pointer(&b)))
+
+	err := kennitala.IsValidKennitalaWithOptions(defaultValidationOptions(kennitalaType))
+
+	if validationError, ok := err.(*ValidationError); ok {
+		validationError.Input = Kennitala(append([]byte(nil), validationError.Input...))
+	}
+	if OnValidation != nil {
+		OnValidation(Kennitala(b), kennitalaType, err)
+	}
+	return err
+}
@@ -0,0 +1,44 @@
+package kennitala
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsValidBytesSuccess(t *testing.T) {
+	for _, input := range []string{"0101303019", "010130-3019", "6204830369"} {
+		if err := IsValidBytes([]byte(input), KennitalaAllTypes); err != nil {
+			t.Errorf("Test Fail: %s", input)
+		}
+	}
+}
+
+func TestIsValidBytesMatchesIsValidKennitala(t *testing.T) {
+	for _, sample := range InvalidSamples {
+		err := IsValidBytes([]byte(sample.K), KennitalaAllTypes)
+		if !errors.Is(err, sample.Err) || err.Error() != sample.K.IsValidKennitala(KennitalaAllTypes).Error() {
+			t.Errorf("Test Fail: %s", sample.K)
+		}
+	}
+}
+
+func TestIsValidBytesCopiesInputSuccess(t *testing.T) {
+	b := []byte("0101303029")
+	err := IsValidBytes(b, KennitalaIndividual)
+	copy(b, "xxxxxxxxxx")
+
+	var validationError *ValidationError
+	if !errors.As(err, &validationError) || validationError.Input != "0101303029" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestIsValidBytesZeroAllocsSuccess(t *testing.T) {
+	b := []byte("0101303019")
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = IsValidBytes(b, KennitalaAllTypes)
+	})
+	if allocs != 0 {
+		t.Errorf("Test Fail: %v allocations", allocs)
+	}
+}
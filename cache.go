@@ -0,0 +1,100 @@
+package kennitala
+
+import (
+	"container/list"
+	"sync"
+)
+
+// cacheKey identifies a cached validation result.
+type cacheKey struct {
+	kennitala     Kennitala
+	kennitalaType KennitalaType
+}
+
+// cacheResult boxes an error, which may be nil, for storage in a sync.Map.
+type cacheResult struct {
+	err error
+}
+
+// Cache memoizes IsValidKennitala for workloads that validate the same
+// kennitala over and over. Results are kept forever, so use LRUCache when
+// the set of inputs is not bounded. The zero value is ready to use and a
+// Cache is safe for concurrent use.
+type Cache struct {
+	results sync.Map
+}
+
+// Valid returns the result of kennitala.IsValidKennitala(kennitalaType),
+// computing it only the first time.
+func (cache *Cache) Valid(kennitala Kennitala, kennitalaType KennitalaType) error {
+	key := cacheKey{kennitala, kennitalaType}
+	if result, ok := cache.results.Load(key); ok {
+		return result.(cacheResult).err
+	}
+
+	err := kennitala.IsValidKennitala(kennitalaType)
+	cache.results.Store(key, cacheResult{err})
+	return err
+}
+
+// LRUCache is a Cache holding at most a fixed number of results, evicting
+// the least recently used one when full. It is safe for concurrent use.
+type LRUCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[cacheKey]*list.Element
+}
+
+type lruEntry struct {
+	key cacheKey
+	err error
+}
+
+// NewLRUCache returns an LRUCache holding at most size results. A size below
+// one is treated as one.
+func NewLRUCache(size int) *LRUCache {
+	if size < 1 {
+		size = 1
+	}
+	return &LRUCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[cacheKey]*list.Element, size),
+	}
+}
+
+// Valid returns the result of kennitala.IsValidKennitala(kennitalaType),
+// computing it unless it is still cached.
+func (cache *LRUCache) Valid(kennitala Kennitala, kennitalaType KennitalaType) error {
+	key := cacheKey{kennitala, kennitalaType}
+
+	cache.mu.Lock()
+	if element, ok := cache.entries[key]; ok {
+		cache.order.MoveToFront(element)
+		cache.mu.Unlock()
+		return element.Value.(*lruEntry).err
+	}
+	cache.mu.Unlock()
+
+	err := kennitala.IsValidKennitala(kennitalaType)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if _, ok := cache.entries[key]; !ok {
+		cache.entries[key] = cache.order.PushFront(&lruEntry{key, err})
+		if cache.order.Len() > cache.size {
+			oldest := cache.order.Back()
+			cache.order.Remove(oldest)
+			delete(cache.entries, oldest.Value.(*lruEntry).key)
+		}
+	}
+	return err
+}
+
+// Len returns the number of cached results.
+func (cache *LRUCache) Len() int {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	return cache.order.Len()
+}
@@ -0,0 +1,70 @@
+package kennitala
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestCacheSuccess(t *testing.T) {
+	var cache Cache
+	for i := 0; i < 2; i++ {
+		if err := cache.Valid("0101303019", KennitalaIndividual); err != nil {
+			t.Errorf("Test Fail")
+		}
+		if err := cache.Valid("0101303019", KennitalaCompany); !errors.Is(err, ErrInvalidKennitalaFirstLetter) {
+			t.Errorf("Test Fail")
+		}
+		if err := cache.Valid("0101303029", KennitalaIndividual); !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+			t.Errorf("Test Fail")
+		}
+	}
+}
+
+func TestCacheConcurrentSuccess(t *testing.T) {
+	var cache Cache
+	lru := NewLRUCache(2)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, kennitala := range []Kennitala{"0101303019", "6204830369", "8101011059"} {
+				if cache.Valid(kennitala, KennitalaAllTypes) != nil || lru.Valid(kennitala, KennitalaAllTypes) != nil {
+					t.Errorf("Test Fail")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLRUCacheEvictionSuccess(t *testing.T) {
+	cache := NewLRUCache(2)
+	cache.Valid("0101303019", KennitalaAllTypes)
+	cache.Valid("6204830369", KennitalaAllTypes)
+	cache.Valid("0101303019", KennitalaAllTypes)
+	cache.Valid("8101011059", KennitalaAllTypes)
+
+	if cache.Len() != 2 {
+		t.Errorf("Test Fail")
+	}
+	if _, ok := cache.entries[cacheKey{"6204830369", KennitalaAllTypes}]; ok {
+		t.Errorf("Test Fail: least recently used entry kept")
+	}
+	if _, ok := cache.entries[cacheKey{"0101303019", KennitalaAllTypes}]; !ok {
+		t.Errorf("Test Fail: recently used entry evicted")
+	}
+}
+
+func TestLRUCacheResultSuccess(t *testing.T) {
+	cache := NewLRUCache(0)
+	for i := 0; i < 2; i++ {
+		if err := cache.Valid("0101303029", KennitalaAllTypes); !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+			t.Errorf("Test Fail")
+		}
+	}
+	if cache.Len() != 1 {
+		t.Errorf("Test Fail")
+	}
+}
@@ -0,0 +1,109 @@
+// Command kennitala validates kennitala given as arguments, or one per line
+// on standard input when there are no arguments.
+//
+// Usage:
+//
+//	kennitala [-type individual|company|system|all] [-json] [kennitala ...]
+//
+// Each input is reported, with surrounding whitespace trimmed, as OK or
+// INVALID with the reason, or as a JSON object per line with -json. The exit
+// code is 1 if any input is invalid, and 2 for usage errors or if reading
+// the input or writing the report fails.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/noona-hq/kennitala"
+)
+
+var kennitalaTypes = map[string]kennitala.KennitalaType{
+	"individual": kennitala.KennitalaIndividual,
+	"company":    kennitala.KennitalaCompany,
+	"system":     kennitala.KennitalaSystem,
+	"all":        kennitala.KennitalaAllTypes,
+}
+
+type result struct {
+	Input string `json:"input"`
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("kennitala", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	typeName := flags.String("type", "all", "kennitala type: individual, company, system or all")
+	jsonOutput := flags.Bool("json", false, "print one JSON object per input")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	kennitalaType, ok := kennitalaTypes[strings.ToLower(*typeName)]
+	if !ok {
+		fmt.Fprintf(stderr, "kennitala: unknown type %q\n", *typeName)
+		return 2
+	}
+
+	encoder := json.NewEncoder(stdout)
+	failed := false
+	report := func(input string) error {
+		input = strings.TrimSpace(input)
+		_, err := kennitala.Parse(input, kennitalaType)
+		failed = failed || err != nil
+
+		if *jsonOutput {
+			r := result{Input: input, Valid: err == nil}
+			if err != nil {
+				r.Error = err.Error()
+			}
+			return encoder.Encode(r)
+		}
+
+		if err != nil {
+			_, err = fmt.Fprintf(stdout, "%s: INVALID: %v\n", input, err)
+		} else {
+			_, err = fmt.Fprintf(stdout, "%s: OK\n", input)
+		}
+		return err
+	}
+
+	if flags.NArg() > 0 {
+		for _, input := range flags.Args() {
+			if err := report(input); err != nil {
+				fmt.Fprintf(stderr, "kennitala: %v\n", err)
+				return 2
+			}
+		}
+	} else {
+		scanner := bufio.NewScanner(stdin)
+		for scanner.Scan() {
+			if strings.TrimSpace(scanner.Text()) == "" {
+				continue
+			}
+			if err := report(scanner.Text()); err != nil {
+				fmt.Fprintf(stderr, "kennitala: %v\n", err)
+				return 2
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			fmt.Fprintf(stderr, "kennitala: %v\n", err)
+			return 2
+		}
+	}
+
+	if failed {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRunArgsSuccess(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-type", "individual", "0101303019", "010130-3019"}, nil, &stdout, &stderr)
+	if code != 0 || stdout.String() != "0101303019: OK\n010130-3019: OK\n" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestRunStdinInvalidFail(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-type", "company"}, strings.NewReader("6204830369\n0101303019\n"), &stdout, &stderr)
+	if code != 1 || stdout.String() != "6204830369: OK\n0101303019: INVALID: invalid first letter at position 0\n" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestRunJSONSuccess(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-json", "0101303019", "0101303029"}, nil, &stdout, &stderr)
+	expected := `{"input":"0101303019","valid":true}` + "\n" +
+		`{"input":"0101303029","valid":false,"error":"invalid check digit at position 8"}` + "\n"
+	if code != 1 || stdout.String() != expected {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestRunJSONStdinSuccess(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-json"}, strings.NewReader(" 010130-3019\r\n\n"), &stdout, &stderr)
+	if code != 0 || stdout.String() != `{"input":"010130-3019","valid":true}`+"\n" {
+		t.Errorf("Test Fail")
+	}
+
+	// Arguments are reported the same way as lines read from standard input
+	stdout.Reset()
+	code = run([]string{"-json", " 010130-3019"}, nil, &stdout, &stderr)
+	if code != 0 || stdout.String() != `{"input":"010130-3019","valid":true}`+"\n" {
+		t.Errorf("Test Fail")
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestRunWriteErrorFail(t *testing.T) {
+	for _, args := range [][]string{{"0101303019"}, {"-json", "0101303019"}} {
+		var stderr bytes.Buffer
+		if code := run(args, nil, failingWriter{}, &stderr); code != 2 || !strings.Contains(stderr.String(), "disk full") {
+			t.Errorf("Test Fail: %v", args)
+		}
+	}
+}
+
+func TestRunUnknownTypeFail(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-type", "bank", "0101303019"}, nil, &stdout, &stderr)
+	if code != 2 {
+		t.Errorf("Test Fail")
+	}
+}
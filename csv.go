@@ -0,0 +1,66 @@
+package kennitala
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// RowResult is the outcome of validating the kennitala in one CSV row.
+type RowResult struct {
+	// Row is the 1-based number of the row, counting any header row.
+	Row int
+	// Value is the cell as read from the CSV.
+	Value string
+	// Kennitala is the normalized kennitala, or Value unchanged if it is
+	// invalid.
+	Kennitala Kennitala
+	// Err is nil for a valid kennitala, ErrMissingColumn when the row is too
+	// short to have the column and otherwise the validation error.
+	Err error
+}
+
+// ValidateCSVColumn reads CSV from r and normalizes and validates the
+// kennitala in column columnIndex, counted from 0, of every row. Rows may
+// have differing numbers of fields. The results read before a CSV syntax
+// error are returned along with the error.
+func ValidateCSVColumn(r io.Reader, columnIndex int, kennitalaType KennitalaType) ([]RowResult, error) {
+	return validateCSVColumn(r, columnIndex, kennitalaType, false)
+}
+
+// ValidateCSVColumnWithHeader is like ValidateCSVColumn but skips the first
+// row as a header. Row numbers still count the header.
+func ValidateCSVColumnWithHeader(r io.Reader, columnIndex int, kennitalaType KennitalaType) ([]RowResult, error) {
+	return validateCSVColumn(r, columnIndex, kennitalaType, true)
+}
+
+func validateCSVColumn(r io.Reader, columnIndex int, kennitalaType KennitalaType, header bool) ([]RowResult, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	var results []RowResult
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return results, nil
+		}
+		if err != nil {
+			return results, err
+		}
+		if header && row == 1 {
+			continue
+		}
+
+		if columnIndex < 0 || columnIndex >= len(record) {
+			results = append(results, RowResult{Row: row, Err: errMissingColumn()})
+			continue
+		}
+
+		value := record[columnIndex]
+		kennitala, err := Parse(value, kennitalaType)
+		if err != nil {
+			kennitala = Kennitala(value)
+		}
+		results = append(results, RowResult{Row: row, Value: value, Kennitala: kennitala, Err: err})
+	}
+}
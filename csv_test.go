@@ -0,0 +1,51 @@
+package kennitala
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateCSVColumnSuccess(t *testing.T) {
+	input := "Jón,010130-3019\nMarel,6204830369\nGuðrún,0101303029\nAnna\n"
+	results, err := ValidateCSVColumn(strings.NewReader(input), 1, KennitalaIndividual)
+	if err != nil || len(results) != 4 {
+		t.Fatalf("Test Fail: %v", err)
+	}
+
+	if results[0].Row != 1 || results[0].Value != "010130-3019" || results[0].Kennitala != "0101303019" || results[0].Err != nil {
+		t.Errorf("Test Fail: %+v", results[0])
+	}
+	if !errors.Is(results[1].Err, ErrInvalidKennitalaFirstLetter) || results[1].Kennitala != "6204830369" {
+		t.Errorf("Test Fail: %+v", results[1])
+	}
+	if !errors.Is(results[2].Err, ErrInvalidKennitalaCheckDigit) || results[2].Kennitala != "0101303029" {
+		t.Errorf("Test Fail: %+v", results[2])
+	}
+	if results[3].Row != 4 || !errors.Is(results[3].Err, ErrMissingColumn) {
+		t.Errorf("Test Fail: %+v", results[3])
+	}
+}
+
+func TestValidateCSVColumnWithHeaderSuccess(t *testing.T) {
+	input := "kennitala,nafn\n\"0101303019\",Jón\n"
+	results, err := ValidateCSVColumnWithHeader(strings.NewReader(input), 0, KennitalaAllTypes)
+	if err != nil || len(results) != 1 || results[0].Row != 2 || results[0].Err != nil {
+		t.Errorf("Test Fail: %+v", results)
+	}
+}
+
+func TestValidateCSVColumnSyntaxFail(t *testing.T) {
+	input := "0101303019\n\"unterminated\n"
+	results, err := ValidateCSVColumn(strings.NewReader(input), 0, KennitalaAllTypes)
+	if err == nil || len(results) != 1 || results[0].Err != nil {
+		t.Errorf("Test Fail: %v", err)
+	}
+}
+
+func TestValidateCSVColumnNegativeIndexFail(t *testing.T) {
+	results, err := ValidateCSVColumn(strings.NewReader("0101303019\n"), -1, KennitalaAllTypes)
+	if err != nil || len(results) != 1 || !errors.Is(results[0].Err, ErrMissingColumn) {
+		t.Errorf("Test Fail")
+	}
+}
@@ -0,0 +1,34 @@
+package kennitala
+
+// Dedup returns the unique kennitala in ks in the order they first appear,
+// normalized as by Normalize so "120174-3389" and "1201743389" count as one.
+// Entries that cannot be normalized are kept unchanged; use DedupValid to
+// drop invalid entries as well.
+func Dedup(ks []Kennitala) []Kennitala {
+	return dedup(ks, func(kennitala Kennitala) bool { return true })
+}
+
+// DedupValid is like Dedup but also drops entries that are not valid for
+// kennitalaType.
+func DedupValid(ks []Kennitala, kennitalaType KennitalaType) []Kennitala {
+	return dedup(ks, func(kennitala Kennitala) bool {
+		return kennitala.IsValidKennitala(kennitalaType) == nil
+	})
+}
+
+func dedup(ks []Kennitala, keep func(Kennitala) bool) []Kennitala {
+	seen := make(map[Kennitala]struct{}, len(ks))
+	unique := make([]Kennitala, 0, len(ks))
+	for _, kennitala := range ks {
+		kennitala = kennitala.canonical()
+		if _, ok := seen[kennitala]; ok {
+			continue
+		}
+		seen[kennitala] = struct{}{}
+
+		if keep(kennitala) {
+			unique = append(unique, kennitala)
+		}
+	}
+	return unique
+}
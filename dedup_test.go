@@ -0,0 +1,36 @@
+package kennitala
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDedupSuccess(t *testing.T) {
+	ks := []Kennitala{"010130-3019", "6204830369", "0101303019", "010130 3019", "0101303029", "620483-0369", "0101303029"}
+	expected := []Kennitala{"0101303019", "6204830369", "0101303029"}
+	if unique := Dedup(ks); !reflect.DeepEqual(unique, expected) {
+		t.Errorf("Test Fail: %v", unique)
+	}
+}
+
+func TestDedupInvalidKeptSuccess(t *testing.T) {
+	ks := []Kennitala{"abc", "abc", ""}
+	expected := []Kennitala{"abc", ""}
+	if unique := Dedup(ks); !reflect.DeepEqual(unique, expected) {
+		t.Errorf("Test Fail: %v", unique)
+	}
+}
+
+func TestDedupValidSuccess(t *testing.T) {
+	ks := []Kennitala{"0101303029", "010130-3019", "6204830369", "0101303019", "abc"}
+	expected := []Kennitala{"0101303019"}
+	if unique := DedupValid(ks, KennitalaIndividual); !reflect.DeepEqual(unique, expected) {
+		t.Errorf("Test Fail: %v", unique)
+	}
+}
+
+func TestDedupEmptySuccess(t *testing.T) {
+	if unique := Dedup(nil); len(unique) != 0 {
+		t.Errorf("Test Fail")
+	}
+}
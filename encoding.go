@@ -0,0 +1,109 @@
+package kennitala
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+)
+
+// MarshalJSON encodes the kennitala as a JSON string in its canonical ten
+// digit form, so the dashed display form is written without the dash.
+func (kennitala Kennitala) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(kennitala.canonical()))
+}
+
+// UnmarshalJSON decodes a JSON string into the kennitala, normalizing it and
+// rejecting values that are not valid for any kennitala type. A JSON null or
+// an empty string decodes to the empty Kennitala.
+func (kennitala *Kennitala) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*kennitala = ""
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	decoded, err := decode(value, "JSON")
+	if err != nil {
+		return err
+	}
+
+	*kennitala = decoded
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, emitting the canonical ten
+// digit form.
+func (kennitala Kennitala) MarshalText() ([]byte, error) {
+	return []byte(kennitala.canonical()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler with the same
+// normalization and validation as UnmarshalJSON. Empty text decodes to the
+// empty Kennitala.
+func (kennitala *Kennitala) UnmarshalText(text []byte) error {
+	decoded, err := decode(string(text), "text")
+	if err != nil {
+		return err
+	}
+
+	*kennitala = decoded
+	return nil
+}
+
+// MarshalXML encodes the kennitala as a text element in its canonical ten
+// digit form.
+func (kennitala Kennitala) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(string(kennitala.canonical()), start)
+}
+
+// UnmarshalXML decodes a text element with the same normalization and
+// validation as UnmarshalJSON. An empty element decodes to the empty
+// Kennitala.
+func (kennitala *Kennitala) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value string
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+
+	decoded, err := decode(value, "XML")
+	if err != nil {
+		return err
+	}
+
+	*kennitala = decoded
+	return nil
+}
+
+// decode normalizes and validates a value read by one of the decoders,
+// treating empty values as the empty Kennitala.
+func decode(value string, format string) (Kennitala, error) {
+	if Kennitala(value).IsEmpty() {
+		return "", nil
+	}
+
+	decoded, err := Parse(value, KennitalaAllTypes)
+	if err != nil {
+		return "", fmt.Errorf("kennitala: cannot unmarshal %s: %w", format, err)
+	}
+	return decoded, nil
+}
+
+// Proto returns the kennitala in its canonical ten digit form for use in a
+// protobuf string field.
+func (kennitala Kennitala) Proto() string {
+	return string(kennitala.canonical())
+}
+
+// FromProto normalizes and validates a kennitala read from a protobuf string
+// field. The empty string means the field is unset and returns the empty
+// Kennitala without an error.
+func FromProto(s string) (Kennitala, error) {
+	if s == "" {
+		return "", nil
+	}
+	return Parse(s, KennitalaAllTypes)
+}
@@ -0,0 +1,204 @@
+package kennitala
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+)
+
+type jsonCustomer struct {
+	Kennitala Kennitala `json:"kennitala"`
+}
+
+func TestMarshalJSONSuccess(t *testing.T) {
+	data, err := json.Marshal(jsonCustomer{Kennitala: "0101303019"})
+	if err != nil || string(data) != `{"kennitala":"0101303019"}` {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestUnmarshalJSONSuccess(t *testing.T) {
+	var customer jsonCustomer
+	err := json.Unmarshal([]byte(`{"kennitala":"6204830369"}`), &customer)
+	if err != nil || customer.Kennitala != "6204830369" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestUnmarshalJSONNullSuccess(t *testing.T) {
+	customer := jsonCustomer{Kennitala: "0101303019"}
+	err := json.Unmarshal([]byte(`{"kennitala":null}`), &customer)
+	if err != nil || customer.Kennitala != "" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestUnmarshalJSONInvalidFail(t *testing.T) {
+	var customer jsonCustomer
+	err := json.Unmarshal([]byte(`{"kennitala":"0101303029"}`), &customer)
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestUnmarshalJSONNotStringFail(t *testing.T) {
+	var customer jsonCustomer
+	err := json.Unmarshal([]byte(`{"kennitala":101303019}`), &customer)
+	if err == nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestMarshalJSONCanonicalSuccess(t *testing.T) {
+	data, err := json.Marshal(jsonCustomer{Kennitala: "010130-3019"})
+	if err != nil || string(data) != `{"kennitala":"0101303019"}` {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestJSONRoundTripDashedSuccess(t *testing.T) {
+	data, err := json.Marshal(jsonCustomer{Kennitala: "620483-0369"})
+	if err != nil {
+		t.Fatalf("Test Fail: %s", err)
+	}
+
+	var customer jsonCustomer
+	if err := json.Unmarshal(data, &customer); err != nil || customer.Kennitala != "6204830369" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestUnmarshalJSONNormalizesSuccess(t *testing.T) {
+	var customer jsonCustomer
+	err := json.Unmarshal([]byte(`{"kennitala":"620483-0369"}`), &customer)
+	if err != nil || customer.Kennitala != "6204830369" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestMarshalTextSuccess(t *testing.T) {
+	var kennitala Kennitala = "010130 3019"
+	text, err := kennitala.MarshalText()
+	if err != nil || string(text) != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestUnmarshalTextMapKeySuccess(t *testing.T) {
+	// encoding/json decodes map keys through UnmarshalText
+	var visits map[Kennitala]int
+	err := json.Unmarshal([]byte(`{"010130-3019":2}`), &visits)
+	if err != nil || visits["0101303019"] != 2 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestUnmarshalTextMapKeyInvalidFail(t *testing.T) {
+	var visits map[Kennitala]int
+	err := json.Unmarshal([]byte(`{"010130-3029":2}`), &visits)
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestProtoSuccess(t *testing.T) {
+	var kennitala Kennitala = "010130-3019"
+	if kennitala.Proto() != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestFromProtoSuccess(t *testing.T) {
+	kennitala, err := FromProto("620483-0369")
+	if err != nil || kennitala != "6204830369" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestFromProtoUnsetSuccess(t *testing.T) {
+	kennitala, err := FromProto("")
+	if err != nil || kennitala != "" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestFromProtoInvalidFail(t *testing.T) {
+	_, err := FromProto("0101303029")
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestUnmarshalJSONEmptySuccess(t *testing.T) {
+	customer := jsonCustomer{Kennitala: "0101303019"}
+	err := json.Unmarshal([]byte(`{"kennitala":" "}`), &customer)
+	if err != nil || customer.Kennitala != "" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestUnmarshalTextEmptySuccess(t *testing.T) {
+	kennitala := Kennitala("0101303019")
+	err := kennitala.UnmarshalText(nil)
+	if err != nil || kennitala != "" {
+		t.Errorf("Test Fail")
+	}
+}
+
+type xmlCustomer struct {
+	XMLName   xml.Name  `xml:"customer"`
+	Name      string    `xml:"name"`
+	Kennitala Kennitala `xml:"kennitala"`
+}
+
+type xmlOrder struct {
+	XMLName  xml.Name    `xml:"order"`
+	Customer xmlCustomer `xml:"customer"`
+}
+
+func TestMarshalXMLSuccess(t *testing.T) {
+	data, err := xml.Marshal(xmlOrder{Customer: xmlCustomer{Name: "Jón", Kennitala: "010130-3019"}})
+	expected := "<order><customer><name>Jón</name><kennitala>0101303019</kennitala></customer></order>"
+	if err != nil || string(data) != expected {
+		t.Errorf("Test Fail: %s", data)
+	}
+}
+
+func TestXMLRoundTripSuccess(t *testing.T) {
+	order := xmlOrder{Customer: xmlCustomer{Name: "Marel hf.", Kennitala: "6204830369"}}
+	data, err := xml.Marshal(order)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded xmlOrder
+	err = xml.Unmarshal(data, &decoded)
+	if err != nil || decoded.Customer.Name != order.Customer.Name || decoded.Customer.Kennitala != order.Customer.Kennitala {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestUnmarshalXMLNormalizesSuccess(t *testing.T) {
+	var customer xmlCustomer
+	err := xml.Unmarshal([]byte("<customer><kennitala> 010130-3019 </kennitala></customer>"), &customer)
+	if err != nil || customer.Kennitala != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestUnmarshalXMLEmptySuccess(t *testing.T) {
+	customer := xmlCustomer{Kennitala: "0101303019"}
+	err := xml.Unmarshal([]byte("<customer><kennitala/></customer>"), &customer)
+	if err != nil || customer.Kennitala != "" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestUnmarshalXMLInvalidFail(t *testing.T) {
+	var customer xmlCustomer
+	err := xml.Unmarshal([]byte("<customer><kennitala>0101303029</kennitala></customer>"), &customer)
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
@@ -0,0 +1,30 @@
+package kennitala
+
+import "fmt"
+
+// ValidationError describes why a kennitala failed validation. Reason is one
+// of the exported sentinel errors, so errors.Is keeps matching it, and
+// Position is the index of the offending character in the ten digit form, or
+// -1 when the failure is not tied to one position, as for the length.
+type ValidationError struct {
+	Reason   error
+	Position int
+	Input    Kennitala
+}
+
+// Error describes the failure without the input, so validation errors can
+// be logged without leaking the kennitala.
+func (err *ValidationError) Error() string {
+	if err.Position < 0 {
+		return err.Reason.Error()
+	}
+	return fmt.Sprintf("%v at position %d", err.Reason, err.Position)
+}
+
+func (err *ValidationError) Unwrap() error {
+	return err.Reason
+}
+
+func invalid(kennitala Kennitala, reason error, position int) error {
+	return &ValidationError{Reason: reason, Position: position, Input: kennitala}
+}
@@ -0,0 +1,112 @@
+package kennitala
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrorsIsWrappedSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303029"
+	err := fmt.Errorf("customer 42: %w", kennitala.IsPerson())
+	if !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestErrorsIsJSONSuccess(t *testing.T) {
+	var kennitala Kennitala
+	err := json.Unmarshal([]byte(`"0101303029"`), &kennitala)
+	if !errors.Is(err, ErrInvalidKennitalaCheckDigit) || errors.Is(err, ErrInvalidKennitalaLength) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestErrorsIsImpossibleCheckDigitSuccess(t *testing.T) {
+	// No check digit makes 41112312 valid, so a zero in its place must not pass
+	var kennitala Kennitala = "4111231209"
+	err := kennitala.IsValidKennitala(KennitalaCompany)
+	if !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestErrorsIsNonNumericSuccess(t *testing.T) {
+	var kennitala Kennitala = "01013030X9"
+	err := kennitala.IsPerson()
+	if !errors.Is(err, ErrInvalidKennitalaNonNumeric) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidationErrorPositionSuccess(t *testing.T) {
+	tests := map[Kennitala]int{
+		"0101303029": 8,
+		"0101303017": 9,
+		"3202303019": 0,
+		"01013030X9": 8,
+		"0101303":    -1,
+	}
+	for kennitala, position := range tests {
+		var validationError *ValidationError
+		err := kennitala.IsPerson()
+		if !errors.As(err, &validationError) || validationError.Position != position || validationError.Input != kennitala {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestValidationErrorCheckPositionSuccess(t *testing.T) {
+	// Each check is called on its own, as validateNumeric would otherwise
+	// catch these first
+	opts := defaultValidationOptions(KennitalaIndividual)
+	tests := []struct {
+		err      error
+		position int
+	}{
+		{validateCentury("01013x3019", opts), 5},
+		{validateCentury("x101303019", opts), 0},
+		{validateCentury("3201303019", opts), 0},
+		{validateCheckDigit("0101x03019", opts), 4},
+		{validateCheckDigit("01013030x9", opts), 8},
+	}
+	for i, test := range tests {
+		var validationError *ValidationError
+		if !errors.As(test.err, &validationError) || validationError.Position != test.position {
+			t.Errorf("Test Fail: %d", i)
+		}
+	}
+}
+
+func TestValidationErrorUnwrapSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303029"
+	err := kennitala.IsPerson()
+	if !errors.Is(err, ErrInvalidKennitalaCheckDigit) || err.Error() != "invalid check digit at position 8" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidationErrorMessageOmitsInputSuccess(t *testing.T) {
+	var kennitala Kennitala = "01013"
+	err := kennitala.IsPerson()
+	if err.Error() != "invalid length" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestImpossibleCheckDigitSuccess(t *testing.T) {
+	var kennitala Kennitala = "4111231209"
+	err := kennitala.IsCompany()
+	if !errors.Is(err, ErrImpossibleCheckDigit) || !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestMistypedCheckDigitNotImpossibleSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303029"
+	err := kennitala.IsPerson()
+	if errors.Is(err, ErrImpossibleCheckDigit) || !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
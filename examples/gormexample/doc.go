@@ -0,0 +1,5 @@
+// Package gormexample shows Kennitala used as a GORM model field. It is a
+// separate module so the kennitala package itself does not depend on GORM.
+// Unlike the kennitala package it needs Go 1.18 or later, as GORM and the
+// SQLite driver use generics.
+package gormexample
@@ -0,0 +1,85 @@
+package gormexample
+
+import (
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/noona-hq/kennitala"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type Customer struct {
+	ID        uint
+	Kennitala kennitala.Kennitala
+}
+
+func openDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&Customer{}); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestGormColumnTypeSuccess(t *testing.T) {
+	db := openDB(t)
+
+	columnTypes, err := db.Migrator().ColumnTypes(&Customer{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, columnType := range columnTypes {
+		if columnType.Name() != "kennitala" {
+			continue
+		}
+		if length, ok := columnType.Length(); !ok || length != 10 {
+			t.Errorf("Test Fail")
+		}
+		return
+	}
+	t.Errorf("Test Fail")
+}
+
+func TestGormRoundTripSuccess(t *testing.T) {
+	db := openDB(t)
+
+	if err := db.Create(&Customer{Kennitala: "0101303019"}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	var customer Customer
+	if err := db.First(&customer).Error; err != nil || customer.Kennitala != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestGormNormalizesOnReadSuccess(t *testing.T) {
+	db := openDB(t)
+
+	if err := db.Exec("INSERT INTO customers (kennitala) VALUES (?)", "010130-3019").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	var customer Customer
+	if err := db.First(&customer).Error; err != nil || customer.Kennitala != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestGormNullSuccess(t *testing.T) {
+	db := openDB(t)
+
+	if err := db.Create(&Customer{}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	var count int64
+	db.Model(&Customer{}).Where("kennitala IS NULL").Count(&count)
+	if count != 1 {
+		t.Errorf("Test Fail")
+	}
+}
@@ -0,0 +1,4 @@
+// Package yamlexample shows Kennitala decoded from YAML through
+// encoding.TextUnmarshaler. It is a separate module so the kennitala package
+// itself does not depend on a YAML library.
+package yamlexample
@@ -0,0 +1,10 @@
+module github.com/noona-hq/kennitala/examples/yamlexample
+
+go 1.17
+
+require (
+	github.com/noona-hq/kennitala v0.0.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+replace github.com/noona-hq/kennitala => ../..
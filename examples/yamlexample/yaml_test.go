@@ -0,0 +1,36 @@
+package yamlexample
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/noona-hq/kennitala"
+	"gopkg.in/yaml.v3"
+)
+
+type Customer struct {
+	Kennitala kennitala.Kennitala `yaml:"kennitala"`
+}
+
+func TestUnmarshalYAMLSuccess(t *testing.T) {
+	var customer Customer
+	err := yaml.Unmarshal([]byte("kennitala: 010130-3019\n"), &customer)
+	if err != nil || customer.Kennitala != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestUnmarshalYAMLInvalidFail(t *testing.T) {
+	var customer Customer
+	err := yaml.Unmarshal([]byte("kennitala: 010130-3029\n"), &customer)
+	if err == nil || !errors.Is(err, kennitala.ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestMarshalYAMLSuccess(t *testing.T) {
+	data, err := yaml.Marshal(Customer{Kennitala: "010130-3019"})
+	if err != nil || string(data) != "kennitala: \"0101303019\"\n" {
+		t.Errorf("Test Fail: %s", data)
+	}
+}
@@ -0,0 +1,60 @@
+package kennitala
+
+// Serial returns the two digit serial (raðtala) in the seventh and eighth
+// positions. Serials are assigned by Registers Iceland to tell apart
+// kennitala sharing a date and carry no meaning beyond that. The dashed form
+// is accepted.
+func (kennitala Kennitala) Serial() (int, error) {
+	kennitala = undash(kennitala)
+	if len(kennitala) != 10 {
+		return 0, errInvalidKennitalaLength()
+	}
+
+	serial, ok := twoDigits(kennitala, 6)
+	if !ok {
+		return 0, errInvalidKennitalaNonNumeric()
+	}
+	return serial, nil
+}
+
+// DatePrefix returns the first six digits, DDMMYY, verbatim: for companies
+// the day still includes the +40 offset and the century is not resolved.
+// It returns the empty string unless the kennitala is ten characters long,
+// with or without the dash.
+func (kennitala Kennitala) DatePrefix() string {
+	kennitala = undash(kennitala)
+	if len(kennitala) != 10 {
+		return ""
+	}
+	return string(kennitala[:6])
+}
+
+// SerialAndCheck returns the last four digits: the serial, the check digit
+// and the century digit. Like DatePrefix it returns the empty string unless
+// the kennitala is ten characters long, with or without the dash.
+func (kennitala Kennitala) SerialAndCheck() string {
+	kennitala = undash(kennitala)
+	if len(kennitala) != 10 {
+		return ""
+	}
+	return string(kennitala[6:])
+}
+
+// Digits returns the values of the ten digits, for code doing its own
+// arithmetic on a kennitala. Only the length and that every character is a
+// digit are checked.
+func (kennitala Kennitala) Digits() ([10]int8, error) {
+	var digits [10]int8
+	if len(kennitala) != 10 {
+		return digits, errInvalidKennitalaLength()
+	}
+
+	for i := range digits {
+		digit := kennitala[i] - '0'
+		if digit > 9 {
+			return [10]int8{}, errInvalidKennitalaNonNumeric()
+		}
+		digits[i] = int8(digit)
+	}
+	return digits, nil
+}
@@ -0,0 +1,70 @@
+package kennitala
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSerialSuccess(t *testing.T) {
+	var kennitala Kennitala = "6204830369" // Marel hf.
+	serial, err := kennitala.Serial()
+	if err != nil || serial != 3 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestSerialDashedSuccess(t *testing.T) {
+	var kennitala Kennitala = "620483-0369" // Marel hf.
+	serial, err := kennitala.Serial()
+	if err != nil || serial != 3 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestSerialFail(t *testing.T) {
+	tests := map[Kennitala]error{
+		"010130":     ErrInvalidKennitalaLength,
+		"010130X019": ErrInvalidKennitalaNonNumeric,
+	}
+	for kennitala, expected := range tests {
+		_, err := kennitala.Serial()
+		if err == nil || !errors.Is(err, expected) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestDatePrefixSuccess(t *testing.T) {
+	var kennitala Kennitala = "620483-0369" // Marel hf.
+	if kennitala.DatePrefix() != "620483" || kennitala.SerialAndCheck() != "0369" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestDatePrefixFail(t *testing.T) {
+	var kennitala Kennitala = "010130"
+	if kennitala.DatePrefix() != "" || kennitala.SerialAndCheck() != "" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestDigitsSuccess(t *testing.T) {
+	var kennitala Kennitala = "6204830369" // Marel hf.
+	digits, err := kennitala.Digits()
+	if err != nil || digits != [10]int8{6, 2, 0, 4, 8, 3, 0, 3, 6, 9} {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestDigitsFail(t *testing.T) {
+	tests := map[Kennitala]error{
+		"010130-3019": ErrInvalidKennitalaLength,
+		"010130X019":  ErrInvalidKennitalaNonNumeric,
+	}
+	for kennitala, expected := range tests {
+		digits, err := kennitala.Digits()
+		if !errors.Is(err, expected) || digits != [10]int8{} {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package kennitala
+
+// FindKennitala returns the first kennitala in text, in normalized form. A
+// candidate is ten digits, optionally with a dash after the sixth, not
+// directly preceded or followed by another digit. Candidates that fail
+// validation are skipped, so longer numbers and lookalikes in the text do
+// not yield false positives.
+func FindKennitala(text string) (Kennitala, bool) {
+	found := find(text, 1)
+	if len(found) == 0 {
+		return "", false
+	}
+	return found[0], true
+}
+
+// FindAllKennitala returns every kennitala in text, in the order they
+// appear and in normalized form. See FindKennitala for what is matched.
+func FindAllKennitala(text string) []Kennitala {
+	return find(text, -1)
+}
+
+// find returns at most limit valid kennitala in text, or all of them when
+// limit is negative.
+func find(text string, limit int) []Kennitala {
+	var found []Kennitala
+	for i := 0; i < len(text) && limit != 0; i++ {
+		if !isDigit(text, i) || isDigit(text, i-1) {
+			continue
+		}
+
+		end := candidateEnd(text, i)
+		if end < 0 {
+			continue
+		}
+
+		kennitala, err := Normalize(text[i:end])
+		if err != nil || kennitala.IsValidKennitala(KennitalaAllTypes) != nil {
+			continue
+		}
+
+		found = append(found, kennitala)
+		limit--
+		i = end - 1
+	}
+	return found
+}
+
+// candidateEnd returns the end of the kennitala shaped substring starting at
+// start, or -1 if there is none.
+func candidateEnd(text string, start int) int {
+	if digitRun(text, start) == 10 {
+		return start + 10
+	}
+	if digitRun(text, start) == 6 && start+6 < len(text) && text[start+6] == '-' && digitRun(text, start+7) == 4 {
+		return start + 11
+	}
+	return -1
+}
+
+// digitRun returns the number of consecutive digits in text at start.
+func digitRun(text string, start int) int {
+	n := 0
+	for isDigit(text, start+n) {
+		n++
+	}
+	return n
+}
+
+func isDigit(text string, i int) bool {
+	return i >= 0 && i < len(text) && text[i] >= '0' && text[i] <= '9'
+}
@@ -0,0 +1,42 @@
+package kennitala
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindKennitalaSuccess(t *testing.T) {
+	kennitala, ok := FindKennitala("Nafn: Jón Jónsson, kt. 010130-3019, sími 5551234")
+	if !ok || kennitala != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestFindKennitalaSkipsInvalidSuccess(t *testing.T) {
+	kennitala, ok := FindKennitala("ref 0101303029 kt 6204830369")
+	if !ok || kennitala != "6204830369" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestFindKennitalaFail(t *testing.T) {
+	for _, text := range []string{"", "no numbers here", "12345678901234", "account 01013030190", "0101303029"} {
+		if _, ok := FindKennitala(text); ok {
+			t.Errorf("Test Fail: %s", text)
+		}
+	}
+}
+
+func TestFindAllKennitalaSuccess(t *testing.T) {
+	found := FindAllKennitala("0101303019,620483-0369;0101303029 x8101011059")
+	expected := []Kennitala{"0101303019", "6204830369", "8101011059"}
+	if !reflect.DeepEqual(found, expected) {
+		t.Errorf("Test Fail: %v", found)
+	}
+}
+
+func TestFindAllKennitalaEmptySuccess(t *testing.T) {
+	if found := FindAllKennitala("01013030190"); len(found) != 0 {
+		t.Errorf("Test Fail: %v", found)
+	}
+}
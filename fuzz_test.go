@@ -0,0 +1,32 @@
+//go:build go1.18
+// +build go1.18
+
+package kennitala
+
+import "testing"
+
+func FuzzIsValidKennitala(f *testing.F) {
+	for _, seed := range []string{"0101303019", "6204830369", "8101011059", "010130-3019", "12A174338X", "+101303019", ""} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		kennitala := Kennitala(input)
+		err := kennitala.IsValidKennitala(KennitalaAllTypes)
+		_ = kennitala.Validate(KennitalaAllTypes)
+		if err != nil {
+			return
+		}
+
+		// A valid kennitala must consist of ten digits once the dash is removed
+		digits := undash(kennitala)
+		if len(digits) != 10 {
+			t.Fatalf("valid kennitala %q has %d characters", input, len(digits))
+		}
+		for i := 0; i < len(digits); i++ {
+			if digits[i] < '0' || digits[i] > '9' {
+				t.Fatalf("valid kennitala %q contains a non-digit", input)
+			}
+		}
+	})
+}
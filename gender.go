@@ -0,0 +1,17 @@
+package kennitala
+
+type Gender int8
+
+const (
+	GenderUnknown Gender = iota
+	GenderMale
+	GenderFemale
+)
+
+// Gender always returns GenderUnknown. Unlike national identifiers in some
+// other countries, an Icelandic kennitala does not encode sex, so it cannot
+// be inferred from the number. GenderMale and GenderFemale exist only so
+// code abstracting over several countries can share one Gender type.
+func (kennitala Kennitala) Gender() Gender {
+	return GenderUnknown
+}
@@ -0,0 +1,11 @@
+package kennitala
+
+import "testing"
+
+func TestGenderUnknownSuccess(t *testing.T) {
+	for _, kennitala := range []Kennitala{"0101303019", "6204830369", ""} {
+		if kennitala.Gender() != GenderUnknown {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package kennitala
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+var (
+	randomIndividualFrom = time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+	randomIndividualDays = int(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC).Sub(randomIndividualFrom).Hours() / 24)
+)
+
+// GenerateIndividual builds a valid individual kennitala for the given
+// birthdate and two-digit serial (10-99). Birthdates must be between 1800 and
+// 2099, or ErrBirthYearOutOfRange is returned. ErrImpossibleCheckDigit is
+// returned when the serial cannot form a valid kennitala for the date, in
+// which case another serial should be tried.
+func GenerateIndividual(birthdate time.Time, serial int) (Kennitala, error) {
+	if serial < 10 {
+		return "", errInvalidKennitalaSerial()
+	}
+
+	year, month, day := birthdate.Date()
+	return compose(day, month, year, serial)
+}
+
+// GenerateCompany builds a valid company kennitala for the given registration
+// date and two-digit serial (0-99), encoding the day as day + 40. The same
+// date range and check digit rules as GenerateIndividual apply.
+func GenerateCompany(registrationDate time.Time, serial int) (Kennitala, error) {
+	// With the day between 1 and 31 the first digit is always 4 to 7, the
+	// company range
+	year, month, day := registrationDate.Date()
+	return compose(day+40, month, year, serial)
+}
+
+// FromBirthdate builds a kennitala of the given type, KennitalaIndividual or
+// KennitalaCompany, as GenerateIndividual or GenerateCompany would, and
+// validates the result before returning it. The century digit is inferred
+// from the year, and ErrBirthYearOutOfRange is returned for years outside
+// 1800 to 2099.
+func FromBirthdate(birthdate time.Time, serial int, kennitalaType KennitalaType) (Kennitala, error) {
+	var kennitala Kennitala
+	var err error
+	switch kennitalaType {
+	case KennitalaIndividual:
+		kennitala, err = GenerateIndividual(birthdate, serial)
+	case KennitalaCompany:
+		kennitala, err = GenerateCompany(birthdate, serial)
+	default:
+		return "", errInvalidKennitalaType()
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err := kennitala.IsValidKennitala(kennitalaType); err != nil {
+		return "", err
+	}
+	return kennitala, nil
+}
+
+// ValidSerialsFor returns the kennitala of the given type, KennitalaIndividual
+// or KennitalaCompany, for every serial from 10 to 99 that forms a valid
+// kennitala with the date, in serial order. Serials for which no check digit
+// is possible are left out.
+func ValidSerialsFor(birthdate time.Time, kennitalaType KennitalaType) ([]Kennitala, error) {
+	var ks []Kennitala
+	for serial := 10; serial <= 99; serial++ {
+		kennitala, err := FromBirthdate(birthdate, serial, kennitalaType)
+		if errors.Is(err, errImpossibleCheckDigit()) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		ks = append(ks, kennitala)
+	}
+	return ks, nil
+}
+
+// RandomIndividual returns a random valid individual kennitala with a
+// birthdate between 1900 and 2019. The same source produces the same
+// sequence of kennitala, which keeps property based tests reproducible.
+func RandomIndividual(r *rand.Rand) Kennitala {
+	for {
+		birthdate := randomIndividualFrom.AddDate(0, 0, r.Intn(randomIndividualDays))
+		kennitala, err := GenerateIndividual(birthdate, 10+r.Intn(90))
+		if err == nil {
+			return kennitala
+		}
+	}
+}
+
+// Anonymize returns a random valid kennitala of the same type and birth year,
+// with the month, day and serial replaced, and never the kennitala itself.
+// It is meant for sharing datasets used for age based analytics: the birth
+// year is kept, so this is not strong anonymization and the result should
+// still be handled with care. Kerfiskennitala carry no birth year and return
+// an error.
+func (kennitala Kennitala) Anonymize(r *rand.Rand) (Kennitala, error) {
+	original, err := kennitala.MatchedType(KennitalaIndividual | KennitalaCompany)
+	if err != nil {
+		return "", err
+	}
+
+	kennitala = undash(kennitala)
+	year, _, _, err := dateFields(kennitala)
+	if err != nil {
+		return "", err
+	}
+
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	days := 365
+	if isLeapYear(year) {
+		days = 366
+	}
+	for {
+		date := from.AddDate(0, 0, r.Intn(days))
+
+		var anonymized Kennitala
+		if original == KennitalaCompany {
+			anonymized, err = GenerateCompany(date, r.Intn(100))
+		} else {
+			anonymized, err = GenerateIndividual(date, 10+r.Intn(90))
+		}
+		if err == nil && anonymized != kennitala {
+			return anonymized, nil
+		}
+	}
+}
+
+func compose(day int, month time.Month, year int, serial int) (Kennitala, error) {
+	if year < 1800 || year > 2099 {
+		return "", errBirthYearOutOfRange()
+	}
+	if serial < 0 || serial > 99 {
+		return "", errInvalidKennitalaSerial()
+	}
+
+	// The century digit is 8 for the 1800s, 9 for the 1900s and 0 for the 2000s
+	centuryDigit := (year / 100) % 10
+	prefix := fmt.Sprintf("%02d%02d%02d%02d", day, int(month), year%100, serial)
+
+	checkDigit, err := calculateCheckDigit(Kennitala(prefix + "00"))
+	if err != nil {
+		return "", err
+	}
+
+	return Kennitala(fmt.Sprintf("%s%d%d", prefix, checkDigit, centuryDigit)), nil
+}
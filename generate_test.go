@@ -0,0 +1,221 @@
+package kennitala
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestGenerateIndividualSuccess(t *testing.T) {
+	kennitala, err := GenerateIndividual(time.Date(1930, time.January, 1, 0, 0, 0, 0, time.UTC), 30)
+	if err != nil || kennitala != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestGenerateIndividualCenturiesSuccess(t *testing.T) {
+	for _, year := range []int{1800, 1950, 2000, 2099} {
+		kennitala, err := GenerateIndividual(time.Date(year, time.June, 15, 0, 0, 0, 0, time.UTC), 20)
+		if errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+			kennitala, err = GenerateIndividual(time.Date(year, time.June, 15, 0, 0, 0, 0, time.UTC), 21)
+		}
+		if err != nil {
+			t.Errorf("Test Fail: %d", year)
+			continue
+		}
+		if err := kennitala.IsPerson(); err != nil {
+			t.Errorf("Test Fail: %d", year)
+		}
+		if birthdate, _ := kennitala.Birthdate(); birthdate.Year() != year {
+			t.Errorf("Test Fail: %d", year)
+		}
+	}
+}
+
+func TestGenerateIndividualImpossibleCheckDigitFail(t *testing.T) {
+	_, err := GenerateIndividual(time.Date(1930, time.January, 1, 0, 0, 0, 0, time.UTC), 14)
+	if err == nil || !errors.Is(err, ErrImpossibleCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestGenerateIndividualDateOutOfRangeFail(t *testing.T) {
+	for _, year := range []int{1799, 2100} {
+		_, err := GenerateIndividual(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC), 30)
+		if !errors.Is(err, ErrBirthYearOutOfRange) {
+			t.Errorf("Test Fail: %d", year)
+		}
+		_, err = GenerateCompany(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC), 30)
+		if !errors.Is(err, ErrBirthYearOutOfRange) {
+			t.Errorf("Test Fail: %d", year)
+		}
+	}
+}
+
+func TestGenerateIndividualSerialFail(t *testing.T) {
+	for _, serial := range []int{9, 100} {
+		_, err := GenerateIndividual(time.Date(1930, time.January, 1, 0, 0, 0, 0, time.UTC), serial)
+		if err == nil || !errors.Is(err, ErrInvalidKennitalaSerial) {
+			t.Errorf("Test Fail: %d", serial)
+		}
+	}
+}
+
+func TestRandomIndividualSuccess(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		kennitala := RandomIndividual(r)
+		if err := kennitala.IsPerson(); err != nil {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestRandomIndividualDeterministicSuccess(t *testing.T) {
+	first := RandomIndividual(rand.New(rand.NewSource(42)))
+	second := RandomIndividual(rand.New(rand.NewSource(42)))
+	if first != second {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestGenerateCompanySuccess(t *testing.T) {
+	kennitala, err := GenerateCompany(time.Date(1983, time.April, 22, 0, 0, 0, 0, time.UTC), 3)
+	if err != nil || kennitala != "6204830369" { // Marel hf.
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestGenerateCompanyRegistrationDateSuccess(t *testing.T) {
+	kennitala, err := GenerateCompany(time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC), 11)
+	if err != nil || kennitala.IsCompany() != nil {
+		t.Fatalf("Test Fail")
+	}
+	registered, err := kennitala.Birthdate()
+	if err != nil || !registered.Equal(time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestGenerateCompanyImpossibleCheckDigitFail(t *testing.T) {
+	_, err := GenerateCompany(time.Date(1923, time.November, 1, 0, 0, 0, 0, time.UTC), 12)
+	if err == nil || !errors.Is(err, ErrImpossibleCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestAnonymizeSuccess(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, kennitala := range []Kennitala{"0101303019", "010130-3019", "6204830369", "2902002020"} {
+		for i := 0; i < 50; i++ {
+			anonymized, err := kennitala.Anonymize(r)
+			if err != nil || anonymized == undash(kennitala) {
+				t.Fatalf("Test Fail: %s", kennitala)
+			}
+
+			originalType, _ := kennitala.Type()
+			anonymizedType, err := anonymized.Type()
+			if err != nil || anonymizedType != originalType {
+				t.Errorf("Test Fail: %s", anonymized)
+			}
+
+			original, _ := undash(kennitala).Birthdate()
+			birthdate, _ := anonymized.Birthdate()
+			if birthdate.Year() != original.Year() {
+				t.Errorf("Test Fail: %s", anonymized)
+			}
+		}
+	}
+}
+
+func TestAnonymizeFail(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, kennitala := range []Kennitala{"8101011059", "0101303029"} {
+		if _, err := kennitala.Anonymize(r); err == nil {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestFromBirthdateSuccess(t *testing.T) {
+	kennitala, err := FromBirthdate(time.Date(1930, time.January, 1, 0, 0, 0, 0, time.UTC), 30, KennitalaIndividual)
+	if err != nil || kennitala != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+
+	kennitala, err = FromBirthdate(time.Date(1983, time.April, 22, 0, 0, 0, 0, time.UTC), 3, KennitalaCompany)
+	if err != nil || kennitala != "6204830369" {
+		t.Errorf("Test Fail")
+	}
+
+	kennitala, err = FromBirthdate(time.Date(2000, time.February, 29, 0, 0, 0, 0, time.UTC), 20, KennitalaIndividual)
+	if err != nil || kennitala != "2902002020" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestFromBirthdateYearOutOfRangeFail(t *testing.T) {
+	for _, year := range []int{1799, 2100} {
+		_, err := FromBirthdate(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC), 30, KennitalaIndividual)
+		if !errors.Is(err, ErrBirthYearOutOfRange) {
+			t.Errorf("Test Fail: %d", year)
+		}
+	}
+}
+
+func TestFromBirthdateTypeFail(t *testing.T) {
+	birthdate := time.Date(1930, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for _, kennitalaType := range []KennitalaType{KennitalaSystem, KennitalaAllTypes, 0} {
+		if _, err := FromBirthdate(birthdate, 30, kennitalaType); !errors.Is(err, ErrInvalidKennitalaType) {
+			t.Errorf("Test Fail: %d", kennitalaType)
+		}
+	}
+}
+
+func TestFromBirthdateImpossibleCheckDigitFail(t *testing.T) {
+	birthdate := time.Date(1930, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := FromBirthdate(birthdate, 14, KennitalaIndividual); !errors.Is(err, ErrImpossibleCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidSerialsForSuccess(t *testing.T) {
+	ks, err := ValidSerialsFor(time.Date(1930, time.January, 1, 0, 0, 0, 0, time.UTC), KennitalaIndividual)
+	if err != nil {
+		t.Fatalf("Test Fail: %s", err)
+	}
+
+	// Eight serials have no possible check digit for 1 January 1930
+	impossible := map[int]bool{14: true, 28: true, 31: true, 45: true, 59: true, 62: true, 76: true, 93: true}
+	if len(ks) != 90-len(impossible) || ks[0] != "0101301079" || ks[len(ks)-1][6:8] != "99" {
+		t.Errorf("Test Fail: %d", len(ks))
+	}
+	for _, kennitala := range ks {
+		serial, _ := kennitala.Serial()
+		if impossible[serial] || kennitala.IsPerson() != nil {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestValidSerialsForCompanySuccess(t *testing.T) {
+	ks, err := ValidSerialsFor(time.Date(1983, time.April, 22, 0, 0, 0, 0, time.UTC), KennitalaCompany)
+	if err != nil || len(ks) == 0 {
+		t.Fatalf("Test Fail")
+	}
+	for _, kennitala := range ks {
+		if kennitala.IsCompany() != nil {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestValidSerialsForFail(t *testing.T) {
+	if _, err := ValidSerialsFor(time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC), KennitalaIndividual); !errors.Is(err, ErrBirthYearOutOfRange) {
+		t.Errorf("Test Fail")
+	}
+	if _, err := ValidSerialsFor(time.Date(1930, time.January, 1, 0, 0, 0, 0, time.UTC), KennitalaSystem); !errors.Is(err, ErrInvalidKennitalaType) {
+		t.Errorf("Test Fail")
+	}
+}
@@ -0,0 +1,31 @@
+package kennitala
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HashWith returns the hex encoded SHA-256 of salt followed by the
+// normalized kennitala, so "120174-3389" and "1201743389" hash the same.
+// Systems sharing a salt can match records by hash without exchanging the
+// kennitala itself.
+//
+// The salt must be kept secret: there are few enough kennitala that anyone
+// knowing the salt can recover one from its hash by trying them all. Hashing
+// is therefore not a substitute for handling kennitala as personal data.
+func (kennitala Kennitala) HashWith(salt []byte) string {
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(kennitala.canonical()))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// LogToken returns the first 8 hex characters of the SHA-256 of the
+// normalized kennitala, a short token for correlating log lines about the
+// same kennitala without logging it. Because it is unsalted and short, the
+// token can be matched against a known kennitala and distinct kennitala may
+// share a token: use it for tracing, not for identification.
+func (kennitala Kennitala) LogToken() string {
+	sum := sha256.Sum256([]byte(kennitala.canonical()))
+	return hex.EncodeToString(sum[:4])
+}
@@ -0,0 +1,43 @@
+package kennitala
+
+import "testing"
+
+func TestHashWithSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	// sha256("salt0101303019")
+	if kennitala.HashWith([]byte("salt")) != "d0b42470f8727fcf4d597a000b24821770ccdfe533d5fc56d2769f7cb53feb99" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestHashWithNormalizesSuccess(t *testing.T) {
+	var plain, dashed Kennitala = "0101303019", "010130-3019"
+	if plain.HashWith([]byte("salt")) != dashed.HashWith([]byte("salt")) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestHashWithSaltFail(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	if kennitala.HashWith([]byte("salt")) == kennitala.HashWith([]byte("pepper")) {
+		t.Errorf("Test Fail")
+	}
+	if kennitala.HashWith(nil) == Kennitala("6204830369").HashWith(nil) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestLogTokenSuccess(t *testing.T) {
+	var plain, dashed Kennitala = "0101303019", "010130-3019"
+	// The first 8 hex characters of sha256("0101303019")
+	if plain.LogToken() != "81f1a4db" || dashed.LogToken() != plain.LogToken() {
+		t.Errorf("Test Fail: %s", plain.LogToken())
+	}
+}
+
+func TestLogTokenFail(t *testing.T) {
+	var a, b Kennitala = "0101303019", "6204830369"
+	if a.LogToken() == b.LogToken() {
+		t.Errorf("Test Fail")
+	}
+}
@@ -1,23 +1,53 @@
 package kennitala
 
 import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
 	kennitalaerrors "github.com/noona-hq/kennitala/kennitalaerror"
-	utils "github.com/noona-hq/kennitala/utils"
 )
 
 var (
 	ErrInvalidKennitalaType        = errInvalidKennitalaType()
 	ErrInvalidKennitalaLength      = errInvalidKennitalaLength()
 	ErrInvalidKennitalaCentury     = errInvalidKennitalaCentury()
+	ErrInvalidKennitalaDate        = errInvalidKennitalaDate()
 	ErrInvalidKennitalaFirstLetter = errInvalidKennitalaFirstLetter()
 	ErrInvalidKennitalaCheckDigit  = errInvalidKennitalaCheckDigit()
+	ErrInvalidKennitalaScanType    = errInvalidKennitalaScanType()
+	ErrInvalidKennitalaNonNumeric  = errInvalidKennitalaNonNumeric()
+	ErrInvalidKennitalaSerial      = errInvalidKennitalaSerial()
+	ErrImpossibleCheckDigit        = errImpossibleCheckDigit()
+	ErrBirthYearOutOfRange         = errBirthYearOutOfRange()
+	ErrNoAgeBracket                = errNoAgeBracket()
+	ErrInvalidKennitalaFormat      = errInvalidKennitalaFormat()
+	ErrInvalidKennitalaMonth       = errInvalidKennitalaMonth()
+	ErrInvalidKennitalaDay         = errInvalidKennitalaDay()
+	ErrMissingColumn               = errMissingColumn()
+	ErrBirthdateInFuture           = errBirthdateInFuture()
+	ErrReservedKennitala           = errReservedKennitala()
 )
 
 func errInvalidKennitalaType() error        { return kennitalaerrors.ErrInvalidKennitalaType }
 func errInvalidKennitalaLength() error      { return kennitalaerrors.ErrInvalidKennitalaLength }
 func errInvalidKennitalaCentury() error     { return kennitalaerrors.ErrInvalidKennitalaCentury }
+func errInvalidKennitalaDate() error        { return kennitalaerrors.ErrInvalidKennitalaDate }
 func errInvalidKennitalaFirstLetter() error { return kennitalaerrors.ErrInvalidKennitalaFirstLetter }
 func errInvalidKennitalaCheckDigit() error  { return kennitalaerrors.ErrInvalidKennitalaCheckDigit }
+func errInvalidKennitalaScanType() error    { return kennitalaerrors.ErrInvalidKennitalaScanType }
+func errInvalidKennitalaNonNumeric() error  { return kennitalaerrors.ErrInvalidKennitalaNonNumeric }
+func errInvalidKennitalaSerial() error      { return kennitalaerrors.ErrInvalidKennitalaSerial }
+func errImpossibleCheckDigit() error        { return kennitalaerrors.ErrImpossibleCheckDigit }
+func errBirthYearOutOfRange() error         { return kennitalaerrors.ErrBirthYearOutOfRange }
+func errNoAgeBracket() error                { return kennitalaerrors.ErrNoAgeBracket }
+func errInvalidKennitalaFormat() error      { return kennitalaerrors.ErrInvalidKennitalaFormat }
+func errInvalidKennitalaMonth() error       { return kennitalaerrors.ErrInvalidKennitalaMonth }
+func errInvalidKennitalaDay() error         { return kennitalaerrors.ErrInvalidKennitalaDay }
+func errMissingColumn() error               { return kennitalaerrors.ErrMissingColumn }
+func errBirthdateInFuture() error           { return kennitalaerrors.ErrBirthdateInFuture }
+func errReservedKennitala() error           { return kennitalaerrors.ErrReservedKennitala }
 
 type Kennitala string
 
@@ -30,92 +60,463 @@ const (
 	KennitalaAllTypes KennitalaType = KennitalaIndividual | KennitalaCompany | KennitalaSystem
 )
 
+// firstDigitTypes maps the first digit of a kennitala to its type
+var firstDigitTypes = [10]KennitalaType{
+	// Kennitala for individuals starts with 0, 1, 2 and 3
+	KennitalaIndividual, KennitalaIndividual, KennitalaIndividual, KennitalaIndividual,
+	// Kennitala for companies starts with 4, 5, 6 and 7
+	KennitalaCompany, KennitalaCompany, KennitalaCompany, KennitalaCompany,
+	// Kerfiskennitala, assigned to people and entities without a regular
+	// kennitala, start with 8 and 9
+	KennitalaSystem, KennitalaSystem,
+}
+
+// String returns "individual", "company", "system" or "all", the names of
+// combined types joined by "|" as in "individual|company", and
+// "unknown(n)" for zero and values with unknown flags.
+func (kennitalaType KennitalaType) String() string {
+	if kennitalaType == KennitalaAllTypes {
+		return "all"
+	}
+	if kennitalaType.isValidKennitalaType() != nil {
+		return "unknown(" + strconv.Itoa(int(kennitalaType)) + ")"
+	}
+
+	var names []string
+	for _, t := range []KennitalaType{KennitalaIndividual, KennitalaCompany, KennitalaSystem} {
+		if kennitalaType.hasFlag(t) {
+			names = append(names, kennitalaTypeNames[t])
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+var kennitalaTypeNames = map[KennitalaType]string{
+	KennitalaIndividual: "individual",
+	KennitalaCompany:    "company",
+	KennitalaSystem:     "system",
+}
+
+// isValidKennitalaType accepts any combination of the kennitala types, such
+// as KennitalaIndividual | KennitalaCompany, but not zero or unknown flags.
 func (kennitalaType KennitalaType) isValidKennitalaType() error {
-	switch kennitalaType {
-	case KennitalaIndividual, KennitalaCompany, KennitalaSystem, KennitalaAllTypes:
-		return nil
+	if kennitalaType == 0 || kennitalaType&^KennitalaAllTypes != 0 {
+		return errInvalidKennitalaType()
 	}
-	return errInvalidKennitalaType()
+	return nil
 }
 
 func (kennitalaType KennitalaType) hasFlag(flag KennitalaType) bool { return kennitalaType&flag != 0 }
 
+// check validates one aspect of a kennitala. Checks only read the positions
+// they need and pass input too short to evaluate, so Validate can run all of
+// them on input of any length.
+type check func(kennitala Kennitala, opts ValidationOptions) error
+
+var checks = []check{
+	validateLength,
+	validateNumeric,
+	validateFormat,
+	validateCentury,
+	yearRangeCheck:   validateYearRange,
+	notFutureCheck:   validateNotFuture,
+	firstLetterCheck: validateFirstLetter,
+	validateCheckDigit,
+}
+
+// Indices in checks of the checks NewValidator drops or replaces.
+const (
+	yearRangeCheck   = 4
+	notFutureCheck   = 5
+	firstLetterCheck = 6
+)
+
+// OnValidation, if set, is called with the arguments and result of every
+// IsValidKennitala call, including those made through helpers such as Valid,
+// IsPerson and Parse, for example to feed metrics. Set it once at startup:
+// it is read without synchronization.
+var OnValidation func(kennitala Kennitala, kennitalaType KennitalaType, err error)
+
+// IsValidKennitala validates the kennitala against the given types. Both the
+// plain ten digit form and the display form with a dash after the sixth
+// digit are accepted.
 func (kennitala Kennitala) IsValidKennitala(kennitalaType KennitalaType) error {
-	if err := kennitalaType.isValidKennitalaType(); err != nil {
-		return err
+	err := kennitala.IsValidKennitalaWithOptions(defaultValidationOptions(kennitalaType))
+	if OnValidation != nil {
+		OnValidation(kennitala, kennitalaType, err)
+	}
+	return err
+}
+
+// Validate runs every check independently and returns all failures, in the
+// order IsValidKennitala would report them. An empty result means the
+// kennitala is valid.
+func (kennitala Kennitala) Validate(kennitalaType KennitalaType) []error {
+	opts := defaultValidationOptions(kennitalaType)
+	if err := opts.Types.isValidKennitalaType(); err != nil {
+		return []error{err}
+	}
+
+	kennitala = opts.prepare(kennitala)
+
+	var errs []error
+	for _, check := range checks {
+		if err := check(kennitala, opts); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
+	return errs
+}
+
+// undash removes the dash from the display form DDMMYY-NNNC. Any other
+// placement of dashes is left for the checks to reject.
+func undash(kennitala Kennitala) Kennitala {
+	if len(kennitala) == 11 && kennitala[6] == '-' {
+		return kennitala[:6] + kennitala[7:]
+	}
+	return kennitala
+}
+
+func validateLength(kennitala Kennitala, opts ValidationOptions) error {
 	if len(kennitala) != 10 {
-		return errInvalidKennitalaLength()
+		return invalid(kennitala, errInvalidKennitalaLength(), -1)
+	}
+	return nil
+}
+
+func validateNumeric(kennitala Kennitala, opts ValidationOptions) error {
+	for i := 0; i < len(kennitala); i++ {
+		if kennitala[i] < '0' || kennitala[i] > '9' {
+			return invalid(kennitala, errInvalidKennitalaNonNumeric(), i)
+		}
 	}
+	return nil
+}
 
-	centuries := map[string]string{
-		"9": "20th",
-		"0": "21st",
+// firstNonDigit returns the index of the first of the first n characters of
+// the kennitala that is not a digit, or -1 if they all are.
+func firstNonDigit(kennitala Kennitala, n int) int {
+	for i := 0; i < n && i < len(kennitala); i++ {
+		if kennitala[i] < '0' || kennitala[i] > '9' {
+			return i
+		}
 	}
-	century := string(kennitala[9])
-	_, exists := centuries[century]
+	return -1
+}
 
-	if !exists {
-		return errInvalidKennitalaCentury()
+// validateFormat rejects placeholder input such as 0000000000 where every
+// digit is the same, which would otherwise fail with a confusing reason or,
+// like 9999999999, even pass as a kerfiskennitala.
+func validateFormat(kennitala Kennitala, opts ValidationOptions) error {
+	if len(kennitala) < 10 {
+		return nil
 	}
+	for i := 1; i < len(kennitala); i++ {
+		if kennitala[i] != kennitala[0] {
+			return nil
+		}
+	}
+	return invalid(kennitala, errInvalidKennitalaFormat(), -1)
+}
 
-	allowFirstLetters := map[string]string{}
-	if kennitalaType.hasFlag(KennitalaIndividual) {
-		// Kennitala for individuals starts with 0, 1, 2 and 3
-		allowFirstLetters["0"] = "0"
-		allowFirstLetters["1"] = "1"
-		allowFirstLetters["2"] = "2"
-		allowFirstLetters["3"] = "3"
+func validateCentury(kennitala Kennitala, opts ValidationOptions) error {
+	if len(kennitala) < 10 {
+		return nil
+	}
+	err := validateBirthdateAndCentury(opts.resolveCentury(kennitala), opts.AllowSystemLooseDate)
+	switch err {
+	case nil:
+		return nil
+	case errInvalidKennitalaCentury():
+		return invalid(kennitala, err, 9)
+	case errInvalidKennitalaMonth():
+		return invalid(kennitala, err, 2)
+	case errInvalidKennitalaDay():
+		return invalid(kennitala, err, 0)
 	}
-	if kennitalaType.hasFlag(KennitalaCompany) {
-		// Kennitala for companies starts with 4, 5, 6 and 7
-		allowFirstLetters["4"] = "4"
-		allowFirstLetters["5"] = "5"
-		allowFirstLetters["6"] = "6"
-		allowFirstLetters["7"] = "7"
+	// The remaining date errors are for a date digit that is not a digit
+	return invalid(kennitala, err, firstNonDigit(kennitala, 6))
+}
+
+func validateYearRange(kennitala Kennitala, opts ValidationOptions) error {
+	if len(kennitala) < 10 || (opts.MinYear == 0 && opts.MaxYear == 0) {
+		return nil
 	}
-	if kennitalaType.hasFlag(KennitalaSystem) {
-		// Kerfiskennitala start with 8 and 9
-		allowFirstLetters["8"] = "8"
-		allowFirstLetters["9"] = "8"
+	if opts.AllowSystemLooseDate && !hasCalendarDate(kennitala) {
+		return nil
 	}
 
-	first := string(kennitala[0])
-	_, exists = allowFirstLetters[first]
+	year, _, _, err := dateFields(opts.resolveCentury(kennitala))
+	if err != nil {
+		// Reported by validateCentury
+		return nil
+	}
+	if (opts.MinYear != 0 && year < opts.MinYear) || (opts.MaxYear != 0 && year > opts.MaxYear) {
+		return invalid(kennitala, errBirthYearOutOfRange(), 4)
+	}
+	return nil
+}
 
-	if !exists {
-		return errInvalidKennitalaFirstLetter()
+func validateNotFuture(kennitala Kennitala, opts ValidationOptions) error {
+	if !opts.RejectFutureDates || len(kennitala) < 10 || kennitala.ProbableType() != KennitalaIndividual {
+		return nil
+	}
+
+	year, month, day, err := dateFields(opts.resolveCentury(kennitala))
+	if err != nil {
+		// Reported by validateCentury
+		return nil
 	}
 
-	checkDigit, _ := utils.StringToInt(string(kennitala[8]))
-	calculatedCheckDigit, _ := calculateCheckDigit(kennitala)
+	nowYear, nowMonth, nowDay := time.Now().UTC().Date()
+	if year > nowYear ||
+		(year == nowYear && month > int(nowMonth)) ||
+		(year == nowYear && month == int(nowMonth) && day > nowDay) {
+		return invalid(kennitala, errBirthdateInFuture(), 0)
+	}
+	return nil
+}
+
+func validateFirstLetter(kennitala Kennitala, opts ValidationOptions) error {
+	if len(kennitala) < 1 {
+		return nil
+	}
+
+	digit := kennitala[0] - '0'
+	if digit > 9 || !opts.Types.hasFlag(firstDigitTypes[digit]) {
+		return invalid(kennitala, errInvalidKennitalaFirstLetter(), 0)
+	}
+	return nil
+}
+
+func validateCheckDigit(kennitala Kennitala, opts ValidationOptions) error {
+	if len(kennitala) < 9 {
+		return nil
+	}
 
-	if checkDigit != calculatedCheckDigit {
-		return errInvalidKennitalaCheckDigit()
+	checkDigit := kennitala[8] - '0'
+	if checkDigit > 9 {
+		return invalid(kennitala, errInvalidKennitalaNonNumeric(), 8)
+	}
+	checkDigitFunc := opts.CheckDigit
+	if checkDigitFunc == nil {
+		checkDigitFunc = Mod11CheckDigit
+	}
+	calculatedCheckDigit, err := calculateCheckDigitWith(kennitala, checkDigitFunc)
+	if opts.AllowImpossibleCheckDigit && errors.Is(err, errImpossibleCheckDigit()) {
+		calculatedCheckDigit, err = 0, nil
+	}
+	if errors.Is(err, errInvalidKennitalaNonNumeric()) {
+		return invalid(kennitala, err, firstNonDigit(kennitala, 8))
+	}
+	if err != nil {
+		return invalid(kennitala, err, 8)
 	}
 
+	if int8(checkDigit) != calculatedCheckDigit {
+		return invalid(kennitala, errInvalidKennitalaCheckDigit(), 8)
+	}
 	return nil
 }
 
+// Valid reports whether the kennitala is valid for the given type. Use
+// IsValidKennitala to find out why a kennitala is invalid.
+func (kennitala Kennitala) Valid(kennitalaType KennitalaType) bool {
+	return kennitala.IsValidKennitala(kennitalaType) == nil
+}
+
+// IsValidLength reports whether the kennitala is ten digits, with or without
+// the dash, as a cheap screen before full validation.
+func IsValidLength(kennitala Kennitala) bool {
+	return kennitala.HasValidStructure() == nil
+}
+
+// HasValidStructure checks only that the kennitala is ten digits, with or
+// without the dash, leaving the date, type and check digit unchecked.
+func (kennitala Kennitala) HasValidStructure() error {
+	kennitala = undash(kennitala)
+	if err := validateLength(kennitala, ValidationOptions{}); err != nil {
+		return err
+	}
+	return validateNumeric(kennitala, ValidationOptions{})
+}
+
 func (kennitala Kennitala) IsPerson() error {
 	return kennitala.IsValidKennitala(KennitalaIndividual)
 }
 
-func calculateCheckDigit(kennitala Kennitala) (int8, error) {
+func (kennitala Kennitala) IsCompany() error {
+	return kennitala.IsValidKennitala(KennitalaCompany)
+}
+
+func (kennitala Kennitala) IsSystem() error {
+	return kennitala.IsValidKennitala(KennitalaSystem)
+}
+
+// Type validates the kennitala against all types and returns the single type
+// it belongs to, based on its first digit.
+func (kennitala Kennitala) Type() (KennitalaType, error) {
+	return kennitala.IsValidAnyType()
+}
+
+// ProbableType returns the type suggested by the first digit alone, or 0 if
+// the kennitala is empty or does not start with a digit. It is a syntactic
+// guess, not validated: use Type to also validate the kennitala.
+func (kennitala Kennitala) ProbableType() KennitalaType {
+	if len(kennitala) == 0 || kennitala[0]-'0' > 9 {
+		return 0
+	}
+	return firstDigitTypes[kennitala[0]-'0']
+}
+
+// HasCompanyDayOffset reports whether the first two digits are 41 to 71, a
+// day of the month with the +40 company offset. It is a separate signal from
+// ProbableType, which only looks at the first digit: kennitala starting with
+// 40 or 72 to 79 have a company first digit but no valid offset day, so a
+// company for which HasCompanyDayOffset is false points to corrupted data.
+// Like ProbableType it does not validate the kennitala.
+func (kennitala Kennitala) HasCompanyDayOffset() bool {
+	day, ok := twoDigits(kennitala, 0)
+	return ok && day >= 41 && day <= 71
+}
+
+// IsValidAnyType validates the kennitala against KennitalaAllTypes and
+// reports which single type it matched: the caller only has to check the
+// error to know the kennitala is legitimate.
+func (kennitala Kennitala) IsValidAnyType() (KennitalaType, error) {
+	return kennitala.MatchedType(KennitalaAllTypes)
+}
+
+// MatchedType validates the kennitala against a combination of types, such
+// as KennitalaIndividual | KennitalaCompany, and reports which single one of
+// them it matched.
+func (kennitala Kennitala) MatchedType(kennitalaType KennitalaType) (KennitalaType, error) {
+	if err := kennitala.IsValidKennitala(kennitalaType); err != nil {
+		return 0, err
+	}
+
+	return firstDigitTypes[undash(kennitala)[0]-'0'], nil
+}
+
+// validateBirthdateAndCentury checks the century digit and, for individuals
+// and companies, that the first six digits form a real calendar date.
+// Kerfiskennitala are assigned algorithmically and their first six digits
+// are not tied to a date of birth, so when looseSystemDate is set only the
+// century digit is checked for them here; the length, digit, first digit and
+// check digit rules still apply to them as to every other kennitala.
+func validateBirthdateAndCentury(kennitala Kennitala, looseSystemDate bool) error {
+	if _, err := century(kennitala); err != nil {
+		return err
+	}
+
+	if looseSystemDate && !hasCalendarDate(kennitala) {
+		return nil
+	}
+
+	_, _, _, err := dateFields(kennitala)
+	return err
+}
+
+// hasCalendarDate reports whether the first six digits of the kennitala are
+// expected to form a date, which is the case for all but kerfiskennitala.
+func hasCalendarDate(kennitala Kennitala) bool {
+	digit := kennitala[0] - '0'
+	return digit > 9 || firstDigitTypes[digit] != KennitalaSystem
+}
+
+// CheckDigit returns the check digit expected at the ninth position given the
+// first eight digits. ErrImpossibleCheckDigit is returned when no check
+// digit can make those digits a valid kennitala.
+func (kennitala Kennitala) CheckDigit() (int, error) {
+	if len(kennitala) != 10 {
+		return -1, errInvalidKennitalaLength()
+	}
+
+	checkDigit, err := calculateCheckDigit(kennitala)
+	if err != nil {
+		return -1, err
+	}
+	return int(checkDigit), nil
+}
+
+// ExplainCheckDigit returns the check digit expected from the first eight
+// digits next to the one actually at the ninth position, for showing why a
+// kennitala fails the check digit rule. When no check digit is possible,
+// expected is -1 and ErrImpossibleCheckDigit is returned along with actual.
+func (kennitala Kennitala) ExplainCheckDigit() (expected int, actual int, err error) {
+	actual, err = kennitala.ProvidedCheckDigit()
+	if err != nil {
+		return -1, -1, err
+	}
+
+	expected, err = kennitala.CheckDigit()
+	return expected, actual, err
+}
+
+// ProvidedCheckDigit returns the check digit at the ninth position as
+// written, to compare with CheckDigit.
+func (kennitala Kennitala) ProvidedCheckDigit() (int, error) {
 	if len(kennitala) != 10 {
 		return -1, errInvalidKennitalaLength()
 	}
 
+	digit := kennitala[8] - '0'
+	if digit > 9 {
+		return -1, errInvalidKennitalaNonNumeric()
+	}
+	return int(digit), nil
+}
+
+// WithValidCheckDigit returns a copy of the kennitala with the ninth digit
+// replaced by the check digit computed from the first eight. The century
+// digit is kept as is. ErrImpossibleCheckDigit is returned when no
+// check digit can make the first eight digits a valid kennitala.
+func (kennitala Kennitala) WithValidCheckDigit() (Kennitala, error) {
+	checkDigit, err := kennitala.CheckDigit()
+	if err != nil {
+		return "", err
+	}
+	return kennitala[:8] + Kennitala('0'+byte(checkDigit)) + kennitala[9:], nil
+}
+
+// CheckDigitFunc computes the check digit from the first eight digits of a
+// kennitala. Mod11CheckDigit is the algorithm used by Registers Iceland;
+// ValidationOptions.CheckDigit can replace it to validate data produced by
+// software that computed check digits differently.
+type CheckDigitFunc func(digits [8]int8) (int8, error)
+
+func calculateCheckDigit(kennitala Kennitala) (int8, error) {
+	return calculateCheckDigitWith(kennitala, Mod11CheckDigit)
+}
+
+func calculateCheckDigitWith(kennitala Kennitala, checkDigitFunc CheckDigitFunc) (int8, error) {
+	if len(kennitala) < 8 {
+		return -1, errInvalidKennitalaLength()
+	}
+
+	var digits [8]int8
+	for i := range digits {
+		digit := kennitala[i] - '0'
+		if digit > 9 {
+			return -1, errInvalidKennitalaNonNumeric()
+		}
+		digits[i] = int8(digit)
+	}
+
+	return checkDigitFunc(digits)
+}
+
+// Mod11CheckDigit is the standard check digit algorithm: the digits are
+// weighted 3, 2, 7, 6, 5, 4, 3, 2 and the check digit is 11 minus the sum
+// modulo 11, or 0 when the sum is divisible by 11. ErrImpossibleCheckDigit
+// is returned when the result would be 10.
+func Mod11CheckDigit(digits [8]int8) (int8, error) {
 	multiples := [8]int8{3, 2, 7, 6, 5, 4, 3, 2}
 
 	sum := uint16(0)
-	for i := uint8(0); i < 8; i++ {
-		num, _ := utils.StringToInt(string(kennitala[i]))
-		// if err != nil {
-		// 	// TODO: handle error
-		// }
-		sum += uint16(num * multiples[i])
+	for i, digit := range digits {
+		sum += uint16(digit * multiples[i])
 	}
 
 	parity := (sum % 11)
@@ -124,7 +525,7 @@ func calculateCheckDigit(kennitala Kennitala) (int8, error) {
 	}
 	parity = 11 - parity
 	if parity == 10 {
-		return 0, errInvalidKennitalaCheckDigit()
+		return 0, errImpossibleCheckDigit()
 	}
 
 	return int8(parity), nil
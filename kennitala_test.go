@@ -2,6 +2,7 @@ package kennitala
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -29,6 +30,66 @@ func TestKennitalaIsNotCompanySuccess(t *testing.T) {
 	}
 }
 
+func TestKennitalaTypeCombinationSuccess(t *testing.T) {
+	types := KennitalaIndividual | KennitalaCompany
+	for _, kennitala := range []Kennitala{"0101303019", "6204830369"} {
+		if err := kennitala.IsValidKennitala(types); err != nil {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+
+	var kennitala Kennitala = "8101011059"
+	if err := kennitala.IsValidKennitala(types); !errors.Is(err, ErrInvalidKennitalaFirstLetter) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestKennitalaTypeUnknownFlagFail(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	for _, kennitalaType := range []KennitalaType{8, KennitalaIndividual | 16, -1} {
+		if err := kennitala.IsValidKennitala(kennitalaType); !errors.Is(err, ErrInvalidKennitalaType) {
+			t.Errorf("Test Fail: %d", kennitalaType)
+		}
+	}
+}
+
+func TestMatchedTypeSuccess(t *testing.T) {
+	tests := map[Kennitala]KennitalaType{
+		"0101303019":  KennitalaIndividual,
+		"620483-0369": KennitalaCompany,
+	}
+	for kennitala, expected := range tests {
+		matched, err := kennitala.MatchedType(KennitalaIndividual | KennitalaCompany)
+		if err != nil || matched != expected {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestMatchedTypeFail(t *testing.T) {
+	var kennitala Kennitala = "9101011029"
+	matched, err := kennitala.MatchedType(KennitalaIndividual | KennitalaCompany)
+	if matched != 0 || !errors.Is(err, ErrInvalidKennitalaFirstLetter) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestKennitalaRepeatedDigitFail(t *testing.T) {
+	for _, kennitala := range []Kennitala{"0000000000", "1111111111", "8888888888", "9999999999", "000000-0000"} {
+		if err := kennitala.IsValidKennitala(KennitalaAllTypes); !errors.Is(err, ErrInvalidKennitalaFormat) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestKennitalaRepeatedDigitGuardSuccess(t *testing.T) {
+	for _, kennitala := range []Kennitala{"0101303019", "8101011059"} {
+		if err := kennitala.IsValidKennitala(KennitalaAllTypes); err != nil {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
 func TestCompanySuccess(t *testing.T) {
 	var kennitala Kennitala = "6204830369" // Marel hf.
 	err := kennitala.IsValidKennitala(KennitalaCompany)
@@ -36,3 +97,471 @@ func TestCompanySuccess(t *testing.T) {
 		t.Errorf("Test Fail")
 	}
 }
+
+func TestCompanyFirstDaySuccess(t *testing.T) {
+	var kennitala Kennitala = "4112231089"
+	err := kennitala.IsValidKennitala(KennitalaCompany)
+	if err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestCompanyLastDaySuccess(t *testing.T) {
+	var kennitala Kennitala = "7112231189"
+	err := kennitala.IsValidKennitala(KennitalaCompany)
+	if err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestCompanyDayOutOfRangeFail(t *testing.T) {
+	var kennitala Kennitala = "7212231089"
+	err := kennitala.IsValidKennitala(KennitalaCompany)
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaDate) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestTypeIndividualSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	kennitalaType, err := kennitala.Type()
+	if err != nil || kennitalaType != KennitalaIndividual {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestTypeCompanySuccess(t *testing.T) {
+	var kennitala Kennitala = "6204830369" // Marel hf.
+	kennitalaType, err := kennitala.Type()
+	if err != nil || kennitalaType != KennitalaCompany {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestTypeSystemSuccess(t *testing.T) {
+	var kennitala Kennitala = "8101011059"
+	kennitalaType, err := kennitala.Type()
+	if err != nil || kennitalaType != KennitalaSystem {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestTypeInvalidFail(t *testing.T) {
+	var kennitala Kennitala = "0101303029"
+	_, err := kennitala.Type()
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestKennitalaTypeStringSuccess(t *testing.T) {
+	tests := map[KennitalaType]string{
+		KennitalaIndividual:                    "individual",
+		KennitalaCompany:                       "company",
+		KennitalaSystem:                        "system",
+		KennitalaAllTypes:                      "all",
+		KennitalaIndividual | KennitalaCompany: "individual|company",
+		KennitalaCompany | KennitalaSystem:     "company|system",
+		0:                                      "unknown(0)",
+		KennitalaIndividual | 16:               "unknown(17)",
+		-1:                                     "unknown(-1)",
+	}
+	for kennitalaType, expected := range tests {
+		if kennitalaType.String() != expected {
+			t.Errorf("Test Fail: %s", kennitalaType)
+		}
+	}
+}
+
+func TestProbableTypeSuccess(t *testing.T) {
+	tests := map[Kennitala]KennitalaType{
+		"0101303019":  KennitalaIndividual,
+		"0101303029":  KennitalaIndividual,
+		"3":           KennitalaIndividual,
+		"6204830369":  KennitalaCompany,
+		"7xyz":        KennitalaCompany,
+		"9101011029":  KennitalaSystem,
+		"":            0,
+		"-0101303019": 0,
+	}
+	for kennitala, expected := range tests {
+		if kennitala.ProbableType() != expected {
+			t.Errorf("Test Fail: %q", kennitala)
+		}
+	}
+}
+
+func TestHasCompanyDayOffsetSuccess(t *testing.T) {
+	for _, kennitala := range []Kennitala{"6204830369", "4101992030", "7112993000", "41"} {
+		if !kennitala.HasCompanyDayOffset() {
+			t.Errorf("Test Fail: %q", kennitala)
+		}
+	}
+}
+
+func TestHasCompanyDayOffsetFail(t *testing.T) {
+	for _, kennitala := range []Kennitala{"0101303019", "4001992030", "7212231089", "9101011029", "4", "4x", ""} {
+		if kennitala.HasCompanyDayOffset() {
+			t.Errorf("Test Fail: %q", kennitala)
+		}
+	}
+}
+
+func TestIsValidAnyTypeSuccess(t *testing.T) {
+	var kennitala Kennitala = "620483-0369" // Marel hf.
+	kennitalaType, err := kennitala.IsValidAnyType()
+	if err != nil || kennitalaType != KennitalaCompany {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestIsValidAnyTypeFail(t *testing.T) {
+	var kennitala Kennitala = "7212231089"
+	kennitalaType, err := kennitala.IsValidAnyType()
+	if kennitalaType != 0 || !errors.Is(err, ErrInvalidKennitalaDate) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestHasValidStructureSuccess(t *testing.T) {
+	for _, kennitala := range []Kennitala{"0101303019", "010130-3019", "0101303029", "3102303019"} {
+		if err := kennitala.HasValidStructure(); err != nil || !IsValidLength(kennitala) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestHasValidStructureFail(t *testing.T) {
+	tests := map[Kennitala]error{
+		"":            ErrInvalidKennitalaLength,
+		"010130 3019": ErrInvalidKennitalaLength,
+		"01013-03019": ErrInvalidKennitalaLength,
+		"010130301X":  ErrInvalidKennitalaNonNumeric,
+	}
+	for kennitala, expected := range tests {
+		if err := kennitala.HasValidStructure(); !errors.Is(err, expected) || IsValidLength(kennitala) {
+			t.Errorf("Test Fail: %q", kennitala)
+		}
+	}
+}
+
+func TestKennitalaIsCompanySuccess(t *testing.T) {
+	var kennitala Kennitala = "6204830369" // Marel hf.
+	err := kennitala.IsCompany()
+	if err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestKennitalaIsSystemSuccess(t *testing.T) {
+	var kennitala Kennitala = "8101011059"
+	err := kennitala.IsSystem()
+	if err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestKennitalaIsCompanyPersonFail(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	err := kennitala.IsCompany()
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaFirstLetter) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestKennitalaValidSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	if !kennitala.Valid(KennitalaIndividual) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestKennitalaValidFail(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	if kennitala.Valid(KennitalaCompany) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestCheckDigitSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	checkDigit, err := kennitala.CheckDigit()
+	if err != nil || checkDigit != 1 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestCheckDigitImpossibleFail(t *testing.T) {
+	var kennitala Kennitala = "4111231209"
+	_, err := kennitala.CheckDigit()
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestMod11CheckDigitSuccess(t *testing.T) {
+	checkDigit, err := Mod11CheckDigit([8]int8{0, 1, 0, 1, 3, 0, 3, 0})
+	if err != nil || checkDigit != 1 {
+		t.Errorf("Test Fail")
+	}
+	if _, err := Mod11CheckDigit([8]int8{4, 1, 1, 1, 2, 3, 1, 2}); !errors.Is(err, ErrImpossibleCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestProvidedCheckDigitSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303029"
+	checkDigit, err := kennitala.ProvidedCheckDigit()
+	if err != nil || checkDigit != 2 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestProvidedCheckDigitFail(t *testing.T) {
+	tests := map[Kennitala]error{
+		"010130-3019": ErrInvalidKennitalaLength,
+		"01013030x9":  ErrInvalidKennitalaNonNumeric,
+	}
+	for kennitala, expected := range tests {
+		if checkDigit, err := kennitala.ProvidedCheckDigit(); !errors.Is(err, expected) || checkDigit != -1 {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestExplainCheckDigitSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303029"
+	expected, actual, err := kennitala.ExplainCheckDigit()
+	if err != nil || expected != 1 || actual != 2 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestExplainCheckDigitImpossibleFail(t *testing.T) {
+	var kennitala Kennitala = "4111231209"
+	expected, actual, err := kennitala.ExplainCheckDigit()
+	if !errors.Is(err, ErrImpossibleCheckDigit) || expected != -1 || actual != 0 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestExplainCheckDigitNonNumericFail(t *testing.T) {
+	var kennitala Kennitala = "01013030x9"
+	if _, _, err := kennitala.ExplainCheckDigit(); !errors.Is(err, ErrInvalidKennitalaNonNumeric) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	errs := kennitala.Validate(KennitalaIndividual)
+	if len(errs) != 0 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateAllFailures(t *testing.T) {
+	var kennitala Kennitala = "0101303020"
+	errs := kennitala.Validate(KennitalaCompany)
+	if len(errs) != 2 ||
+		!errors.Is(errs[0], ErrInvalidKennitalaFirstLetter) ||
+		!errors.Is(errs[1], ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateShortFailures(t *testing.T) {
+	var kennitala Kennitala = "010130302"
+	errs := kennitala.Validate(KennitalaCompany)
+	if len(errs) != 3 ||
+		!errors.Is(errs[0], ErrInvalidKennitalaLength) ||
+		!errors.Is(errs[1], ErrInvalidKennitalaFirstLetter) ||
+		!errors.Is(errs[2], ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateEmptyFailures(t *testing.T) {
+	var kennitala Kennitala = ""
+	errs := kennitala.Validate(KennitalaIndividual)
+	if len(errs) != 1 || !errors.Is(errs[0], ErrInvalidKennitalaLength) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateMonthFailures(t *testing.T) {
+	var kennitala Kennitala = "0113303019"
+	errs := kennitala.Validate(KennitalaIndividual)
+	if len(errs) == 0 || !errors.Is(errs[0], ErrInvalidKennitalaMonth) || errors.Is(errs[0], ErrInvalidKennitalaDay) {
+		t.Errorf("Test Fail")
+	}
+
+	var validationError *ValidationError
+	if !errors.As(errs[0], &validationError) || validationError.Position != 2 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateDayFailures(t *testing.T) {
+	for _, kennitala := range []Kennitala{"3102303019", "2902002029", "7212231089"} {
+		errs := kennitala.Validate(KennitalaAllTypes)
+		if len(errs) == 0 || !errors.Is(errs[0], ErrInvalidKennitalaDay) || !errors.Is(errs[0], ErrInvalidKennitalaDate) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+
+		var validationError *ValidationError
+		if !errors.As(errs[0], &validationError) || validationError.Position != 0 {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestValidateMatchesIsValidKennitala(t *testing.T) {
+	for _, kennitala := range []Kennitala{"0101303019", "0101303029", "6204830369", "01013030", "8101011059"} {
+		errs := kennitala.Validate(KennitalaIndividual)
+		err := kennitala.IsValidKennitala(KennitalaIndividual)
+		if (len(errs) == 0) != (err == nil) || (err != nil && !reflect.DeepEqual(errs[0], err)) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestKennitalaDashedSuccess(t *testing.T) {
+	var kennitala Kennitala = "010130-3019"
+	err := kennitala.IsValidKennitala(KennitalaIndividual)
+	if err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestKennitalaDashedInvalidFail(t *testing.T) {
+	var kennitala Kennitala = "010130-3029"
+	err := kennitala.IsValidKennitala(KennitalaIndividual)
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestKennitalaMalformedDashFail(t *testing.T) {
+	for _, kennitala := range []Kennitala{"01013-03019", "0101303-019", "010130--3019", "01-0130-3019", "010130-30-9"} {
+		err := kennitala.IsValidKennitala(KennitalaIndividual)
+		if err == nil {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestKennitalaNonNumericFail(t *testing.T) {
+	for _, kennitala := range []Kennitala{"12A174338X", "0101303O19", "010130301 "} {
+		err := kennitala.IsValidKennitala(KennitalaAllTypes)
+		if err == nil || !errors.Is(err, ErrInvalidKennitalaNonNumeric) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestKennitalaSystemNineSuccess(t *testing.T) {
+	var kennitala Kennitala = "9101011029"
+	err := kennitala.IsValidKennitala(KennitalaSystem)
+	if err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func BenchmarkIsValidKennitala(b *testing.B) {
+	var kennitala Kennitala = "0101303019"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = kennitala.IsValidKennitala(KennitalaAllTypes)
+	}
+}
+
+func TestOnValidationSuccess(t *testing.T) {
+	defer func() { OnValidation = nil }()
+
+	counts := map[error]int{}
+	OnValidation = func(kennitala Kennitala, kennitalaType KennitalaType, err error) {
+		if kennitalaType != KennitalaIndividual {
+			t.Errorf("Test Fail")
+		}
+		if err != nil {
+			err = errors.Unwrap(err)
+		}
+		counts[err]++
+	}
+
+	for _, kennitala := range []Kennitala{"0101303019", "0101303029", "6204830369"} {
+		_ = kennitala.IsValidKennitala(KennitalaIndividual)
+	}
+	_ = Kennitala("010130-3019").IsPerson()
+
+	if counts[nil] != 2 || counts[ErrInvalidKennitalaCheckDigit] != 1 || counts[ErrInvalidKennitalaFirstLetter] != 1 {
+		t.Errorf("Test Fail: %v", counts)
+	}
+}
+
+func TestIsValidKennitalaZeroAllocsSuccess(t *testing.T) {
+	for _, kennitala := range []Kennitala{"0101303019", "6204830369", "8101011059"} {
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = kennitala.IsValidKennitala(KennitalaAllTypes)
+		})
+		if allocs != 0 {
+			t.Errorf("Test Fail: %s allocates %v times", kennitala, allocs)
+		}
+	}
+}
+
+func TestKennitalaSystemLooseDateSuccess(t *testing.T) {
+	for _, kennitala := range []Kennitala{"8999991189", "9900001070"} {
+		err := kennitala.IsSystem()
+		if err != nil {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestKennitalaSystemRulesStillApplyFail(t *testing.T) {
+	tests := map[Kennitala]error{
+		"8999991181": ErrInvalidKennitalaCentury,
+		"8999991199": ErrInvalidKennitalaCheckDigit,
+		"89999911":   ErrInvalidKennitalaLength,
+	}
+	for kennitala, expected := range tests {
+		err := kennitala.IsSystem()
+		if err == nil || !errors.Is(err, expected) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestKennitalaSystemFirstLetterFail(t *testing.T) {
+	var kennitala Kennitala = "8999991189"
+	err := kennitala.IsPerson()
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaFirstLetter) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateNonNumericFirstLetterFailures(t *testing.T) {
+	var kennitala Kennitala = "X101303019"
+	errs := kennitala.Validate(KennitalaAllTypes)
+	if len(errs) == 0 || !errors.Is(errs[0], ErrInvalidKennitalaNonNumeric) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestWithValidCheckDigitSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303099"
+	repaired, err := kennitala.WithValidCheckDigit()
+	if err != nil || repaired != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestWithValidCheckDigitImpossibleFail(t *testing.T) {
+	var kennitala Kennitala = "4111231209"
+	_, err := kennitala.WithValidCheckDigit()
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
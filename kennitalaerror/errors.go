@@ -1,11 +1,36 @@
 package kennitalaerror
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrInvalidKennitalaType        = errors.New("invalid argument")
 	ErrInvalidKennitalaLength      = errors.New("invalid length")
 	ErrInvalidKennitalaCentury     = errors.New("invalid century")
+	ErrInvalidKennitalaDate        = errors.New("invalid date")
 	ErrInvalidKennitalaFirstLetter = errors.New("invalid first letter")
 	ErrInvalidKennitalaCheckDigit  = errors.New("invalid check digit")
+	ErrInvalidKennitalaScanType    = errors.New("invalid scan type")
+	ErrInvalidKennitalaNonNumeric  = errors.New("invalid non-numeric character")
+	ErrInvalidKennitalaSerial      = errors.New("invalid serial")
+	ErrBirthYearOutOfRange         = errors.New("birth year out of range")
+	ErrNoAgeBracket                = errors.New("no age bracket")
+	ErrInvalidKennitalaFormat      = errors.New("invalid format")
+	ErrMissingColumn               = errors.New("missing column")
+	ErrBirthdateInFuture           = errors.New("birthdate in the future")
+	ErrReservedKennitala           = errors.New("reserved kennitala")
+)
+
+// ErrImpossibleCheckDigit wraps ErrInvalidKennitalaCheckDigit, so errors.Is
+// matches both, while telling apart first eight digits that can never form a
+// valid kennitala from a mistyped check digit.
+var ErrImpossibleCheckDigit = fmt.Errorf("%w: no check digit is possible", ErrInvalidKennitalaCheckDigit)
+
+// ErrInvalidKennitalaMonth and ErrInvalidKennitalaDay wrap
+// ErrInvalidKennitalaDate to tell which part of the date is wrong.
+var (
+	ErrInvalidKennitalaMonth = fmt.Errorf("%w: month out of range", ErrInvalidKennitalaDate)
+	ErrInvalidKennitalaDay   = fmt.Errorf("%w: day out of range", ErrInvalidKennitalaDate)
 )
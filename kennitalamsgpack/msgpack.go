@@ -0,0 +1,93 @@
+// Package kennitalamsgpack encodes and decodes kennitala as MessagePack
+// strings without depending on a MessagePack library. The functions follow
+// the append and decode style of code generated by tinylib/msgp, so they can
+// be called from hand-written or generated marshalers:
+//
+//	b = kennitalamsgpack.AppendMsgpack(b, customer.Kennitala)
+//	customer.Kennitala, b, err = kennitalamsgpack.DecodeMsgpack(b)
+//
+// Decoding has the same semantics as Kennitala.UnmarshalJSON: values are
+// normalized and validated, and nil or an empty string decode to the empty
+// Kennitala.
+package kennitalamsgpack
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/noona-hq/kennitala"
+)
+
+const (
+	msgpackNil   = 0xc0
+	msgpackStr8  = 0xd9
+	msgpackStr16 = 0xda
+	msgpackStr32 = 0xdb
+
+	msgpackFixStr    = 0xa0
+	msgpackFixStrMax = 0xbf
+)
+
+// AppendMsgpack appends the kennitala to b as a MessagePack string in its
+// canonical ten digit form.
+func AppendMsgpack(b []byte, k kennitala.Kennitala) []byte {
+	text, _ := k.MarshalText()
+
+	switch n := len(text); {
+	case n < 32:
+		b = append(b, msgpackFixStr|byte(n))
+	case n < 1<<8:
+		b = append(b, msgpackStr8, byte(n))
+	case n < 1<<16:
+		b = append(b, msgpackStr16, byte(n>>8), byte(n))
+	default:
+		b = append(b, msgpackStr32, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+	return append(b, text...)
+}
+
+// DecodeMsgpack decodes a MessagePack string or nil from the start of b and
+// returns the kennitala along with the remaining bytes.
+func DecodeMsgpack(b []byte) (kennitala.Kennitala, []byte, error) {
+	if len(b) == 0 {
+		return "", b, io.ErrUnexpectedEOF
+	}
+
+	// The length is kept unsigned, as a str32 length can overflow int on
+	// 32-bit platforms
+	var n uint64
+	var header int
+	switch c := b[0]; {
+	case c == msgpackNil:
+		return "", b[1:], nil
+	case c >= msgpackFixStr && c <= msgpackFixStrMax:
+		n, header = uint64(c&0x1f), 1
+	case c == msgpackStr8 && len(b) >= 2:
+		n, header = uint64(b[1]), 2
+	case c == msgpackStr16 && len(b) >= 3:
+		n, header = uint64(binary.BigEndian.Uint16(b[1:])), 3
+	case c == msgpackStr32 && len(b) >= 5:
+		n, header = uint64(binary.BigEndian.Uint32(b[1:])), 5
+	case c == msgpackStr8 || c == msgpackStr16 || c == msgpackStr32:
+		return "", b, io.ErrUnexpectedEOF
+	default:
+		return "", b, fmt.Errorf("kennitala: cannot unmarshal msgpack: type %#x is not a string", c)
+	}
+
+	if uint64(len(b)-header) < n {
+		return "", b, io.ErrUnexpectedEOF
+	}
+	end := header + int(n)
+	value, rest := string(b[header:end]), b[end:]
+
+	if kennitala.Kennitala(value).IsEmpty() {
+		return "", rest, nil
+	}
+
+	decoded, err := kennitala.Parse(value, kennitala.KennitalaAllTypes)
+	if err != nil {
+		return "", b, fmt.Errorf("kennitala: cannot unmarshal msgpack: %w", err)
+	}
+	return decoded, rest, nil
+}
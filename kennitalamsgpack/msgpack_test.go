@@ -0,0 +1,70 @@
+package kennitalamsgpack
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/noona-hq/kennitala"
+)
+
+func TestAppendMsgpackSuccess(t *testing.T) {
+	b := AppendMsgpack(nil, "010130-3019")
+	if !bytes.Equal(b, append([]byte{0xaa}, "0101303019"...)) {
+		t.Errorf("Test Fail: %x", b)
+	}
+}
+
+func TestRoundTripSuccess(t *testing.T) {
+	b := AppendMsgpack(nil, "0101303019")
+	b = AppendMsgpack(b, "6204830369")
+
+	first, rest, err := DecodeMsgpack(b)
+	if err != nil || first != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+	second, rest, err := DecodeMsgpack(rest)
+	if err != nil || second != "6204830369" || len(rest) != 0 {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestDecodeMsgpackStr8Success(t *testing.T) {
+	b := append([]byte{0xd9, 11}, "010130-3019"...)
+	decoded, _, err := DecodeMsgpack(b)
+	if err != nil || decoded != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestDecodeMsgpackEmptySuccess(t *testing.T) {
+	for _, b := range [][]byte{{0xc0}, {0xa0}, AppendMsgpack(nil, "")} {
+		decoded, rest, err := DecodeMsgpack(b)
+		if err != nil || decoded != "" || len(rest) != 0 {
+			t.Errorf("Test Fail: %x", b)
+		}
+	}
+}
+
+func TestDecodeMsgpackInvalidFail(t *testing.T) {
+	b := AppendMsgpack(nil, "0101303029")
+	if _, _, err := DecodeMsgpack(b); !errors.Is(err, kennitala.ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestDecodeMsgpackTypeFail(t *testing.T) {
+	// 42 as a positive fixint
+	if _, _, err := DecodeMsgpack([]byte{0x2a}); err == nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestDecodeMsgpackTruncatedFail(t *testing.T) {
+	for _, b := range [][]byte{nil, {0xaa, '0', '1'}, {0xd9}, {0xda, 0}, {0xdb, 0xff, 0xff, 0xff, 0xff, '0'}} {
+		if _, _, err := DecodeMsgpack(b); err != io.ErrUnexpectedEOF {
+			t.Errorf("Test Fail: %x", b)
+		}
+	}
+}
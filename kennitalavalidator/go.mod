@@ -0,0 +1,19 @@
+module github.com/noona-hq/kennitala/kennitalavalidator
+
+go 1.18
+
+require (
+	github.com/go-playground/validator/v10 v10.11.2
+	github.com/noona-hq/kennitala v0.0.0
+)
+
+require (
+	github.com/go-playground/locales v0.14.1 // indirect
+	github.com/go-playground/universal-translator v0.18.1 // indirect
+	github.com/leodido/go-urn v1.2.1 // indirect
+	golang.org/x/crypto v0.5.0 // indirect
+	golang.org/x/sys v0.4.0 // indirect
+	golang.org/x/text v0.6.0 // indirect
+)
+
+replace github.com/noona-hq/kennitala => ../
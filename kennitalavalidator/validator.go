@@ -0,0 +1,57 @@
+// Package kennitalavalidator adds a kennitala tag to go-playground/validator.
+// It is a separate module so the kennitala package itself does not depend on
+// the validator, and needs Go 1.18 or later as the validator does. Register
+// the tag once at startup:
+//
+//	validate := validator.New()
+//	if err := kennitalavalidator.RegisterValidation(validate); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// A field tagged `validate:"kennitala"` must then hold a valid individual
+// kennitala. The type can be chosen with a parameter, as in
+// `validate:"kennitala=company"`, where the parameter is one of individual,
+// company, system or all. Both string and kennitala.Kennitala fields are
+// supported, in plain or dashed form.
+package kennitalavalidator
+
+import (
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/noona-hq/kennitala"
+)
+
+// Tag is the validation tag registered by RegisterValidation.
+const Tag = "kennitala"
+
+var kennitalaTypes = map[string]kennitala.KennitalaType{
+	"":           kennitala.KennitalaIndividual,
+	"individual": kennitala.KennitalaIndividual,
+	"company":    kennitala.KennitalaCompany,
+	"system":     kennitala.KennitalaSystem,
+	"all":        kennitala.KennitalaAllTypes,
+}
+
+// RegisterValidation registers ValidatorFunc under Tag.
+func RegisterValidation(v *validator.Validate) error {
+	return v.RegisterValidation(Tag, ValidatorFunc)
+}
+
+// ValidatorFunc reports whether the field holds a valid kennitala of the type
+// given by the tag parameter. Like the validator's built in tags it panics on
+// an unknown parameter, which is a programming error.
+func ValidatorFunc(fl validator.FieldLevel) bool {
+	kennitalaType, ok := kennitalaTypes[fl.Param()]
+	if !ok {
+		panic("kennitalavalidator: unknown kennitala type " + fl.Param())
+	}
+
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	_, err := kennitala.Parse(field.String(), kennitalaType)
+	return err == nil
+}
@@ -0,0 +1,75 @@
+package kennitalavalidator
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/noona-hq/kennitala"
+)
+
+type customer struct {
+	Kennitala string `validate:"kennitala"`
+}
+
+type company struct {
+	Kennitala kennitala.Kennitala `validate:"kennitala=company"`
+}
+
+type anyType struct {
+	Kennitala string `validate:"omitempty,kennitala=all"`
+}
+
+func newValidate(t *testing.T) *validator.Validate {
+	validate := validator.New()
+	if err := RegisterValidation(validate); err != nil {
+		t.Fatal(err)
+	}
+	return validate
+}
+
+func TestIndividualSuccess(t *testing.T) {
+	validate := newValidate(t)
+	for _, value := range []string{"0101303019", "010130-3019"} {
+		if err := validate.Struct(customer{Kennitala: value}); err != nil {
+			t.Errorf("Test Fail: %s", value)
+		}
+	}
+}
+
+func TestIndividualFail(t *testing.T) {
+	validate := newValidate(t)
+	for _, value := range []string{"", "0101303029", "6204830369"} {
+		if err := validate.Struct(customer{Kennitala: value}); err == nil {
+			t.Errorf("Test Fail: %s", value)
+		}
+	}
+}
+
+func TestCompanySuccess(t *testing.T) {
+	validate := newValidate(t)
+	if err := validate.Struct(company{Kennitala: "6204830369"}); err != nil {
+		t.Errorf("Test Fail")
+	}
+	if err := validate.Struct(company{Kennitala: "0101303019"}); err == nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestAllTypesSuccess(t *testing.T) {
+	validate := newValidate(t)
+	for _, value := range []string{"", "0101303019", "6204830369", "8101011059"} {
+		if err := validate.Struct(anyType{Kennitala: value}); err != nil {
+			t.Errorf("Test Fail: %s", value)
+		}
+	}
+}
+
+func TestUnknownTypePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Test Fail")
+		}
+	}()
+	validate := newValidate(t)
+	validate.Var("0101303019", "kennitala=bank")
+}
@@ -0,0 +1,54 @@
+package kennitala
+
+import "errors"
+
+// message is the text shown to users for an error, in each supported
+// language.
+type message struct {
+	err error
+	en  string
+	is  string
+}
+
+// messages is matched in order with errors.Is, so errors wrapping another
+// sentinel must come before it.
+var messages = []message{
+	{errImpossibleCheckDigit(), "no check digit can make this kennitala valid", "engin vartala getur gert þessa kennitölu gilda"},
+	{errInvalidKennitalaCheckDigit(), "the check digit is wrong", "vartalan er röng"},
+	{errInvalidKennitalaType(), "invalid kennitala type", "ógild tegund kennitölu"},
+	{errInvalidKennitalaLength(), "a kennitala must be 10 digits", "kennitala verður að vera 10 tölustafir"},
+	{errInvalidKennitalaNonNumeric(), "a kennitala may only contain digits", "kennitala má aðeins innihalda tölustafi"},
+	{errInvalidKennitalaFormat(), "this is not a real kennitala", "þetta er ekki raunveruleg kennitala"},
+	{errInvalidKennitalaCentury(), "the century digit is invalid", "aldarstafurinn er ógildur"},
+	{errInvalidKennitalaMonth(), "the month of birth is invalid", "fæðingarmánuðurinn er ógildur"},
+	{errInvalidKennitalaDay(), "the day of the month of birth is invalid", "fæðingardagurinn er ógildur"},
+	{errInvalidKennitalaDate(), "the date of birth is invalid", "fæðingardagsetningin er ógild"},
+	{errInvalidKennitalaFirstLetter(), "the kennitala is not of the expected type", "kennitalan er ekki af réttri tegund"},
+	{errBirthYearOutOfRange(), "the year of birth is out of the accepted range", "fæðingarárið er utan leyfilegra marka"},
+	{errReservedKennitala(), "the kennitala is reserved", "kennitalan er frátekin"},
+	{errBirthdateInFuture(), "the date of birth is in the future", "fæðingardagurinn er í framtíðinni"},
+	{errNoAgeBracket(), "the age does not fall in any age bracket", "aldurinn fellur ekki í neinn aldursflokk"},
+	{errInvalidKennitalaSerial(), "the serial number is invalid", "raðnúmerið er ógilt"},
+	{errMissingColumn(), "the row has no kennitala column", "í línunni er enginn dálkur fyrir kennitölu"},
+	{errInvalidKennitalaScanType(), "a kennitala cannot be read from this value", "ekki er hægt að lesa kennitölu úr þessu gildi"},
+}
+
+// LocalizedError returns a message for err suitable for showing to users, in
+// Icelandic for lang "is" and in English otherwise. Errors that are not from
+// this package fall back to their own, English, text.
+func LocalizedError(err error, lang string) string {
+	if err == nil {
+		return ""
+	}
+
+	for _, m := range messages {
+		if errors.Is(err, m.err) {
+			if lang == "is" {
+				return m.is
+			}
+			return m.en
+		}
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,45 @@
+package kennitala
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLocalizedErrorSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303029"
+	err := kennitala.IsValidKennitala(KennitalaAllTypes)
+	if LocalizedError(err, "is") != "vartalan er röng" {
+		t.Errorf("Test Fail")
+	}
+	if LocalizedError(err, "en") != "the check digit is wrong" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestLocalizedErrorWrappedSuccess(t *testing.T) {
+	var kennitala Kennitala = "4111231209"
+	err := kennitala.IsValidKennitala(KennitalaAllTypes)
+	if LocalizedError(err, "is") != "engin vartala getur gert þessa kennitölu gilda" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestLocalizedErrorAllSentinelsSuccess(t *testing.T) {
+	for _, m := range messages {
+		if m.en == "" || m.is == "" || LocalizedError(m.err, "is") != m.is {
+			t.Errorf("Test Fail: %s", m.err)
+		}
+	}
+}
+
+func TestLocalizedErrorUnknownLanguageSuccess(t *testing.T) {
+	if LocalizedError(ErrInvalidKennitalaLength, "de") != "a kennitala must be 10 digits" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestLocalizedErrorUnknownErrorSuccess(t *testing.T) {
+	if LocalizedError(errors.New("boom"), "is") != "boom" || LocalizedError(nil, "is") != "" {
+		t.Errorf("Test Fail")
+	}
+}
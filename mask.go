@@ -0,0 +1,47 @@
+package kennitala
+
+import "sync/atomic"
+
+var logRedaction int32
+
+// SetLogRedaction controls whether String returns the Masked form of a
+// kennitala instead of the full value. Enabling it keeps kennitala printed
+// with %v or %s out of logs across the whole program.
+func SetLogRedaction(enabled bool) {
+	var value int32
+	if enabled {
+		value = 1
+	}
+	atomic.StoreInt32(&logRedaction, value)
+}
+
+// String implements fmt.Stringer. It returns the kennitala unchanged unless
+// log redaction is enabled with SetLogRedaction.
+func (kennitala Kennitala) String() string {
+	if atomic.LoadInt32(&logRedaction) == 1 {
+		return kennitala.Masked()
+	}
+	return string(kennitala)
+}
+
+// Masked returns the date portion of the kennitala with the serial and check
+// digits hidden, as in "120174-****", so log lines can be correlated by
+// birthdate without exposing the full kennitala. Malformed values are
+// masked entirely as "****".
+func (kennitala Kennitala) Masked() string {
+	kennitala = undash(kennitala)
+	if len(kennitala) != 10 {
+		return "****"
+	}
+	return string(kennitala[:6]) + "-****"
+}
+
+// FullyMasked hides every digit of the kennitala. Malformed values are
+// masked as "****".
+func (kennitala Kennitala) FullyMasked() string {
+	kennitala = undash(kennitala)
+	if len(kennitala) != 10 {
+		return "****"
+	}
+	return "**********"
+}
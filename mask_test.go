@@ -0,0 +1,52 @@
+package kennitala
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMaskedSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	if kennitala.Masked() != "010130-****" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestMaskedDashedSuccess(t *testing.T) {
+	var kennitala Kennitala = "010130-3019"
+	if kennitala.Masked() != "010130-****" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestFullyMaskedSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	if kennitala.FullyMasked() != "**********" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestMaskedMalformedSuccess(t *testing.T) {
+	for _, kennitala := range []Kennitala{"", "0101", "01013030190101"} {
+		if kennitala.Masked() != "****" || kennitala.FullyMasked() != "****" {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestStringSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	if fmt.Sprintf("%v %s", kennitala, kennitala) != "0101303019 0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestStringRedactedSuccess(t *testing.T) {
+	SetLogRedaction(true)
+	defer SetLogRedaction(false)
+
+	var kennitala Kennitala = "0101303019"
+	if fmt.Sprintf("%v %s", kennitala, kennitala) != "010130-**** 010130-****" {
+		t.Errorf("Test Fail")
+	}
+}
@@ -0,0 +1,212 @@
+package kennitala
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+)
+
+// byteOrderMark is the UTF-8 byte order mark some editors write at the start
+// of text files.
+const byteOrderMark = "\uFEFF"
+
+// Normalize removes spaces, non-breaking spaces, tabs and a single dash after
+// the sixth digit from s, as in "120174-3389" or "120174 3389". A leading
+// byte order mark and trailing line endings, as left by reading lines from a
+// file, are removed too. It returns an error unless exactly ten digits
+// remain. Normalize is the recommended entry point for user input before
+// validation.
+func Normalize(s string) (Kennitala, error) {
+	return NormalizeWith(s, nil)
+}
+
+// NormalizeWith is like Normalize but also removes the first of
+// countryPrefixes the value starts with, such as DefaultCountryPrefixes.
+func NormalizeWith(s string, countryPrefixes []string) (Kennitala, error) {
+	s = strings.TrimRight(strings.TrimPrefix(s, byteOrderMark), "\r\n")
+
+	stripped := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\u00a0':
+			return -1
+		}
+		return r
+	}, s)
+	stripped = stripCountryPrefix(stripped, countryPrefixes)
+
+	if len(stripped) > 6 && stripped[6] == '-' {
+		stripped = stripped[:6] + stripped[7:]
+	}
+
+	if len(stripped) != 10 {
+		return "", errInvalidKennitalaLength()
+	}
+	for i := 0; i < len(stripped); i++ {
+		if stripped[i] < '0' || stripped[i] > '9' {
+			return "", errInvalidKennitalaNonNumeric()
+		}
+	}
+
+	return Kennitala(stripped), nil
+}
+
+// normalizeBytes is Normalize for a byte slice, without country prefixes,
+// writing the result to buf so it does not allocate. The result aliases buf.
+func normalizeBytes(b []byte, buf *[11]byte) ([]byte, error) {
+	b = bytes.TrimRight(bytes.TrimPrefix(b, []byte(byteOrderMark)), "\r\n")
+
+	stripped := buf[:0]
+	for i := 0; i < len(b); i++ {
+		switch {
+		case b[i] == ' ' || b[i] == '\t':
+			continue
+		case b[i] == 0xc2 && i+1 < len(b) && b[i+1] == 0xa0:
+			// The UTF-8 encoding of a non-breaking space
+			i++
+			continue
+		case len(stripped) == len(buf):
+			return nil, errInvalidKennitalaLength()
+		}
+		stripped = append(stripped, b[i])
+	}
+
+	if len(stripped) > 6 && stripped[6] == '-' {
+		stripped = append(stripped[:6], stripped[7:]...)
+	}
+
+	if len(stripped) != 10 {
+		return nil, errInvalidKennitalaLength()
+	}
+	for i := 0; i < len(stripped); i++ {
+		if stripped[i] < '0' || stripped[i] > '9' {
+			return nil, errInvalidKennitalaNonNumeric()
+		}
+	}
+
+	return stripped, nil
+}
+
+// Parse normalizes s and validates the result against kennitalaType.
+func Parse(s string, kennitalaType KennitalaType) (Kennitala, error) {
+	return ParseWith(s, kennitalaType, nil)
+}
+
+// ParseWith is like Parse but normalizes s with NormalizeWith, removing the
+// first of countryPrefixes it starts with.
+func ParseWith(s string, kennitalaType KennitalaType, countryPrefixes []string) (Kennitala, error) {
+	kennitala, err := NormalizeWith(s, countryPrefixes)
+	if err != nil {
+		return "", err
+	}
+	if err := kennitala.IsValidKennitala(kennitalaType); err != nil {
+		return "", err
+	}
+	return kennitala, nil
+}
+
+// DefaultCountryPrefixes are the prefixes WithCountryPrefix removes when
+// called without any.
+var DefaultCountryPrefixes = []string{"IS"}
+
+// stripCountryPrefix removes the first of prefixes that s starts with.
+func stripCountryPrefix(s string, prefixes []string) string {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return s[len(prefix):]
+		}
+	}
+	return s
+}
+
+// ValidateAndNormalize is the method form of Parse: it strips the formatting
+// Normalize removes, validates the result against kennitalaType and returns
+// it in the clean ten digit form.
+func (kennitala Kennitala) ValidateAndNormalize(kennitalaType KennitalaType) (Kennitala, error) {
+	return Parse(string(kennitala), kennitalaType)
+}
+
+// Trusted converts s to a Kennitala without normalizing or validating it. The
+// caller guarantees s is a valid kennitala, for example because it was read
+// back from storage that only holds validated values. Use Parse for any
+// other input.
+func Trusted(s string) Kennitala {
+	return Kennitala(s)
+}
+
+// MustParse is like Parse but panics if s is not a valid kennitala. It is
+// intended for tests and constants.
+func MustParse(s string, kennitalaType KennitalaType) Kennitala {
+	kennitala, err := Parse(s, kennitalaType)
+	if err != nil {
+		panic("kennitala: Parse(" + s + "): " + err.Error())
+	}
+	return kennitala
+}
+
+// Format returns the kennitala in the dashed display form DDMMYY-NNNC. The
+// kennitala is not validated, but values that are not ten characters long
+// are returned unchanged.
+func (kennitala Kennitala) Format() string {
+	return kennitala.FormatWith("-")
+}
+
+// FormatWith is like Format but places sep after the sixth character.
+func (kennitala Kennitala) FormatWith(sep string) string {
+	if len(kennitala) != 10 {
+		return string(kennitala)
+	}
+	return string(kennitala[:6]) + sep + string(kennitala[6:])
+}
+
+// IsEmpty reports whether the kennitala is empty or only whitespace, which
+// the decoders in this package treat as an absent value rather than an
+// invalid one.
+func (kennitala Kennitala) IsEmpty() bool {
+	return strings.TrimSpace(string(kennitala)) == ""
+}
+
+// Equal reports whether two kennitala are the same once dashes and spaces
+// are stripped, so "120174-3389" equals "1201743389".
+func (kennitala Kennitala) Equal(other Kennitala) bool {
+	return kennitala.canonical() == other.canonical()
+}
+
+// canonical returns the normalized kennitala, or the kennitala unchanged if
+// it cannot be normalized.
+func (kennitala Kennitala) canonical() Kennitala {
+	if normalized, err := Normalize(string(kennitala)); err == nil {
+		return normalized
+	}
+	return kennitala
+}
+
+// Canonical returns the kennitala with surrounding whitespace and the dash
+// after the sixth digit removed, checking only that ten digits remain. It is
+// stricter than Normalize about spaces inside the value and, unlike
+// IsValidKennitala, does not check the date or check digit.
+func (kennitala Kennitala) Canonical() (Kennitala, error) {
+	kennitala = undash(Kennitala(strings.TrimSpace(string(kennitala))))
+
+	if len(kennitala) != 10 {
+		return "", errInvalidKennitalaLength()
+	}
+	for i := 0; i < len(kennitala); i++ {
+		if kennitala[i] < '0' || kennitala[i] > '9' {
+			return "", errInvalidKennitalaNonNumeric()
+		}
+	}
+
+	return kennitala, nil
+}
+
+// Strip returns the kennitala with every dash and whitespace character
+// removed, wherever they are. It never fails: the result may still not be
+// ten digits, which validation will catch.
+func (kennitala Kennitala) Strip() Kennitala {
+	return Kennitala(strings.Map(func(r rune) rune {
+		if r == '-' || unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, string(kennitala)))
+}
@@ -0,0 +1,259 @@
+package kennitala
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeSuccess(t *testing.T) {
+	inputs := []string{
+		"0101303019",
+		"010130-3019",
+		"010130\u00a03019",
+		"  010130-3019\t",
+		"010130 3019",
+	}
+	for _, input := range inputs {
+		kennitala, err := Normalize(input)
+		if err != nil || kennitala != "0101303019" {
+			t.Errorf("Test Fail: %q", input)
+		}
+	}
+}
+
+func TestNormalizeDashPositionFail(t *testing.T) {
+	_, err := Normalize("01013-03019")
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaLength) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestNormalizeLengthFail(t *testing.T) {
+	_, err := Normalize("010130-301")
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaLength) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestNormalizeNonNumericFail(t *testing.T) {
+	_, err := Normalize("010130-30X9")
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaNonNumeric) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestFormatSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	if kennitala.Format() != "010130-3019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestFormatWithSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	if kennitala.FormatWith(" ") != "010130 3019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestFormatInvalidLengthSuccess(t *testing.T) {
+	var kennitala Kennitala = "010130"
+	if kennitala.Format() != "010130" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestParseSuccess(t *testing.T) {
+	kennitala, err := Parse(" 010130-3019 ", KennitalaIndividual)
+	if err != nil || kennitala != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestParseInvalidFail(t *testing.T) {
+	_, err := Parse("010130-3019", KennitalaCompany)
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaFirstLetter) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateAndNormalizeSuccess(t *testing.T) {
+	var kennitala Kennitala = " 010130-3019\n"
+	normalized, err := kennitala.ValidateAndNormalize(KennitalaIndividual)
+	if err != nil || normalized != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestValidateAndNormalizeFail(t *testing.T) {
+	tests := map[Kennitala]error{
+		"010130-3029":  ErrInvalidKennitalaCheckDigit,
+		"620483-0369":  ErrInvalidKennitalaFirstLetter,
+		"010130--3019": ErrInvalidKennitalaLength,
+	}
+	for kennitala, expected := range tests {
+		normalized, err := kennitala.ValidateAndNormalize(KennitalaIndividual)
+		if !errors.Is(err, expected) || normalized != "" {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestMustParseSuccess(t *testing.T) {
+	if MustParse("620483-0369", KennitalaCompany) != "6204830369" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestMustParsePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Test Fail")
+		}
+	}()
+	MustParse("010130-3029", KennitalaIndividual)
+}
+
+func TestEqualSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	for _, other := range []Kennitala{"0101303019", "010130-3019", " 010130 3019 "} {
+		if !kennitala.Equal(other) || !other.Equal(kennitala) {
+			t.Errorf("Test Fail: %q", other)
+		}
+	}
+}
+
+func TestEqualFail(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	for _, other := range []Kennitala{"6204830369", "010130-301", ""} {
+		if kennitala.Equal(other) {
+			t.Errorf("Test Fail: %q", other)
+		}
+	}
+}
+
+func TestTrustedSuccess(t *testing.T) {
+	if Trusted("010130-3029") != "010130-3029" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestIsEmptySuccess(t *testing.T) {
+	for _, kennitala := range []Kennitala{"", " ", "\t\n"} {
+		if !kennitala.IsEmpty() {
+			t.Errorf("Test Fail: %q", kennitala)
+		}
+	}
+	for _, kennitala := range []Kennitala{"0101303019", "-", "0"} {
+		if kennitala.IsEmpty() {
+			t.Errorf("Test Fail: %q", kennitala)
+		}
+	}
+}
+
+func TestCanonicalSuccess(t *testing.T) {
+	for _, input := range []Kennitala{"0101303019", " 010130-3019\n", "\t0101303019 "} {
+		kennitala, err := input.Canonical()
+		if err != nil || kennitala != "0101303019" {
+			t.Errorf("Test Fail: %q", input)
+		}
+	}
+}
+
+func TestCanonicalNotValidatedSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303029"
+	canonical, err := kennitala.Canonical()
+	if err != nil || canonical != kennitala {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestCanonicalLengthFail(t *testing.T) {
+	for _, input := range []Kennitala{"", "010130 3019", "01013-03019", "010130--3019"} {
+		if _, err := input.Canonical(); !errors.Is(err, ErrInvalidKennitalaLength) {
+			t.Errorf("Test Fail: %q", input)
+		}
+	}
+}
+
+func TestCanonicalNonNumericFail(t *testing.T) {
+	var kennitala Kennitala = "01013o3019"
+	if _, err := kennitala.Canonical(); !errors.Is(err, ErrInvalidKennitalaNonNumeric) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestNormalizeFileArtifactsSuccess(t *testing.T) {
+	for _, input := range []string{"0101303019\n", "0101303019\r\n", "\uFEFF0101303019", "\uFEFF010130-3019\r\n"} {
+		kennitala, err := Normalize(input)
+		if err != nil || kennitala != "0101303019" {
+			t.Errorf("Test Fail: %q", input)
+		}
+	}
+}
+
+func TestNormalizeInnerByteOrderMarkFail(t *testing.T) {
+	if _, err := Normalize("010130\uFEFF3019"); err == nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestStripSuccess(t *testing.T) {
+	tests := map[Kennitala]Kennitala{
+		"010130-3019":         "0101303019",
+		" 01 01 30-30 19\r\n": "0101303019",
+		"010130\u00a03019":    "0101303019",
+		"--0101-30":           "010130",
+		"abc":                 "abc",
+		"":                    "",
+	}
+	for kennitala, expected := range tests {
+		if stripped := kennitala.Strip(); stripped != expected {
+			t.Errorf("Test Fail: %q", stripped)
+		}
+	}
+}
+
+func TestNormalizeCountryPrefixSuccess(t *testing.T) {
+	for _, input := range []string{"IS0101303019", "IS 010130-3019", "0101303019", "010130-3019"} {
+		kennitala, err := NormalizeWith(input, DefaultCountryPrefixes)
+		if err != nil || kennitala != "0101303019" {
+			t.Errorf("Test Fail: %s", input)
+		}
+	}
+}
+
+func TestNormalizeCountryPrefixFail(t *testing.T) {
+	// Prefixes are only removed when asked for, and only the configured ones
+	if _, err := Normalize("IS0101303019"); err == nil {
+		t.Errorf("Test Fail")
+	}
+	if _, err := NormalizeWith("DK0101303019", DefaultCountryPrefixes); err == nil {
+		t.Errorf("Test Fail")
+	}
+	if _, err := NormalizeWith("ISIS0101303019", DefaultCountryPrefixes); err == nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestParseCountryPrefixSuccess(t *testing.T) {
+	kennitala, err := ParseWith("KT:620483-0369", KennitalaCompany, []string{"IS", "KT:"})
+	if err != nil || kennitala != "6204830369" {
+		t.Errorf("Test Fail")
+	}
+
+	if _, err := ParseWith("IS0101303019", KennitalaCompany, DefaultCountryPrefixes); !errors.Is(err, ErrInvalidKennitalaFirstLetter) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestParseWithOnValidationSuccess(t *testing.T) {
+	defer func(hook func(Kennitala, KennitalaType, error)) { OnValidation = hook }(OnValidation)
+
+	var seen Kennitala
+	OnValidation = func(kennitala Kennitala, _ KennitalaType, _ error) { seen = kennitala }
+
+	if _, err := ParseWith("IS010130-3019", KennitalaIndividual, DefaultCountryPrefixes); err != nil || seen != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
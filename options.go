@@ -0,0 +1,218 @@
+package kennitala
+
+import "strings"
+
+// ValidationOptions configures how strictly IsValidKennitalaWithOptions
+// validates a kennitala.
+type ValidationOptions struct {
+	// Types are the kennitala types to accept.
+	Types KennitalaType
+	// AllowDash accepts the display form with a dash after the sixth digit.
+	AllowDash bool
+	// TrimSpace ignores leading and trailing whitespace, including line
+	// endings, and a leading byte order mark.
+	TrimSpace bool
+	// AllowSystemLooseDate skips the calendar date check for
+	// kerfiskennitala, whose first six digits are not a date of birth.
+	AllowSystemLooseDate bool
+	// MinYear and MaxYear reject dates of birth before or after the given
+	// years, inclusive. Zero leaves that end of the range open, so by
+	// default every year from 1800 to 2099 is accepted.
+	MinYear int
+	MaxYear int
+	// RejectFutureDates rejects individual kennitala whose date of birth is
+	// after the current date in UTC. Companies and kerfiskennitala are
+	// never rejected for it, as some pipelines legitimately hold future
+	// registration dates.
+	RejectFutureDates bool
+	// LegacyCentury accepts kennitala from historical records whose century
+	// digit is not 8, 9 or 0 by reading the date as if the digit were 9,
+	// the 1900s. The date itself must still be a real calendar date in
+	// that century, and every other check still applies; valid century
+	// digits are read as usual.
+	LegacyCentury bool
+	// AllowImpossibleCheckDigit accepts 0 as the check digit where the
+	// modulo 11 computation gives 10, as some other implementations do,
+	// instead of rejecting the kennitala with ErrImpossibleCheckDigit.
+	// Registers Iceland never issues such numbers, so enable it only to
+	// interoperate with data from those implementations: it makes
+	// otherwise impossible kennitala validate.
+	AllowImpossibleCheckDigit bool
+	// CountryPrefixes are removed from the start of the kennitala, so that
+	// "IS1201743389" validates with the prefix "IS". Only the first
+	// matching prefix is removed, and none are by default.
+	CountryPrefixes []string
+	// CheckDigit computes the expected check digit. Nil means
+	// Mod11CheckDigit.
+	CheckDigit CheckDigitFunc
+}
+
+// defaultValidationOptions are the options IsValidKennitala validates with:
+// no whitespace is allowed, but the dashed display form and kerfiskennitala
+// without a calendar date are.
+func defaultValidationOptions(kennitalaType KennitalaType) ValidationOptions {
+	return ValidationOptions{
+		Types:                kennitalaType,
+		AllowDash:            true,
+		AllowSystemLooseDate: true,
+	}
+}
+
+// IsValidKennitalaWithOptions validates the kennitala as configured by opts.
+func (kennitala Kennitala) IsValidKennitalaWithOptions(opts ValidationOptions) error {
+	if err := opts.Types.isValidKennitalaType(); err != nil {
+		return err
+	}
+
+	return opts.validate(kennitala)
+}
+
+// Option sets a field of ValidationOptions for NewValidator.
+type Option func(*ValidationOptions)
+
+// WithAllowDash sets ValidationOptions.AllowDash.
+func WithAllowDash(allow bool) Option {
+	return func(opts *ValidationOptions) { opts.AllowDash = allow }
+}
+
+// WithTrimSpace sets ValidationOptions.TrimSpace.
+func WithTrimSpace(trim bool) Option {
+	return func(opts *ValidationOptions) { opts.TrimSpace = trim }
+}
+
+// WithSystemLooseDate sets ValidationOptions.AllowSystemLooseDate.
+func WithSystemLooseDate(allow bool) Option {
+	return func(opts *ValidationOptions) { opts.AllowSystemLooseDate = allow }
+}
+
+// WithYearRange sets ValidationOptions.MinYear and MaxYear.
+func WithYearRange(min, max int) Option {
+	return func(opts *ValidationOptions) {
+		opts.MinYear = min
+		opts.MaxYear = max
+	}
+}
+
+// WithRejectFutureDates sets ValidationOptions.RejectFutureDates.
+func WithRejectFutureDates(reject bool) Option {
+	return func(opts *ValidationOptions) { opts.RejectFutureDates = reject }
+}
+
+// WithLegacyCentury sets ValidationOptions.LegacyCentury.
+func WithLegacyCentury(legacy bool) Option {
+	return func(opts *ValidationOptions) { opts.LegacyCentury = legacy }
+}
+
+// WithStrictCheckDigit sets ValidationOptions.AllowImpossibleCheckDigit to
+// the opposite of strict. Validation is strict by default.
+func WithStrictCheckDigit(strict bool) Option {
+	return func(opts *ValidationOptions) { opts.AllowImpossibleCheckDigit = !strict }
+}
+
+// WithCheckDigitFunc sets ValidationOptions.CheckDigit.
+func WithCheckDigitFunc(checkDigitFunc CheckDigitFunc) Option {
+	return func(opts *ValidationOptions) { opts.CheckDigit = checkDigitFunc }
+}
+
+// WithCountryPrefix sets ValidationOptions.CountryPrefixes to prefixes, or
+// to DefaultCountryPrefixes if none are given.
+func WithCountryPrefix(prefixes ...string) Option {
+	if len(prefixes) == 0 {
+		prefixes = DefaultCountryPrefixes
+	}
+	return func(opts *ValidationOptions) { opts.CountryPrefixes = prefixes }
+}
+
+// NewValidator returns a function validating kennitala against kennitalaType
+// with the same defaults as IsValidKennitala, changed by opts. The options
+// are resolved and the type checked once, up front, and checks the options
+// disable are left out, as is the year range check when no range is set.
+// The first digits kennitalaType allows are looked up in a precomputed
+// table.
+func NewValidator(kennitalaType KennitalaType, opts ...Option) func(Kennitala) error {
+	options := defaultValidationOptions(kennitalaType)
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if err := options.Types.isValidKennitalaType(); err != nil {
+		return func(Kennitala) error { return err }
+	}
+
+	var allowed [10]bool
+	for digit, digitType := range firstDigitTypes {
+		allowed[digit] = options.Types.hasFlag(digitType)
+	}
+	validateAllowedFirstLetter := func(kennitala Kennitala, _ ValidationOptions) error {
+		if len(kennitala) < 1 {
+			return nil
+		}
+		if digit := kennitala[0] - '0'; digit > 9 || !allowed[digit] {
+			return invalid(kennitala, errInvalidKennitalaFirstLetter(), 0)
+		}
+		return nil
+	}
+
+	active := make([]check, 0, len(checks))
+	for i, c := range checks {
+		switch i {
+		case yearRangeCheck:
+			if options.MinYear == 0 && options.MaxYear == 0 {
+				continue
+			}
+		case notFutureCheck:
+			if !options.RejectFutureDates {
+				continue
+			}
+		case firstLetterCheck:
+			c = validateAllowedFirstLetter
+		}
+		active = append(active, c)
+	}
+
+	return func(kennitala Kennitala) error {
+		kennitala = options.prepare(kennitala)
+		for _, check := range active {
+			if err := check(kennitala, options); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// resolveCentury returns the kennitala to decode the date from, which with
+// LegacyCentury has an unknown century digit replaced by 9.
+func (opts ValidationOptions) resolveCentury(kennitala Kennitala) Kennitala {
+	if opts.LegacyCentury {
+		if _, err := century(kennitala); err != nil {
+			return kennitala[:9] + "9"
+		}
+	}
+	return kennitala
+}
+
+// validate runs the checks on a kennitala, assuming the types are valid.
+func (opts ValidationOptions) validate(kennitala Kennitala) error {
+	kennitala = opts.prepare(kennitala)
+
+	for _, check := range checks {
+		if err := check(kennitala, opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prepare strips the formatting the options allow before the checks run.
+func (opts ValidationOptions) prepare(kennitala Kennitala) Kennitala {
+	if opts.TrimSpace {
+		kennitala = Kennitala(strings.TrimSpace(strings.TrimPrefix(string(kennitala), byteOrderMark)))
+	}
+	kennitala = Kennitala(stripCountryPrefix(string(kennitala), opts.CountryPrefixes))
+	if opts.AllowDash {
+		kennitala = undash(kennitala)
+	}
+	return kennitala
+}
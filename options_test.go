@@ -0,0 +1,296 @@
+package kennitala
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestOptionsStrictSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	err := kennitala.IsValidKennitalaWithOptions(ValidationOptions{Types: KennitalaIndividual})
+	if err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestOptionsStrictDashFail(t *testing.T) {
+	var kennitala Kennitala = "010130-3019"
+	err := kennitala.IsValidKennitalaWithOptions(ValidationOptions{Types: KennitalaIndividual})
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaLength) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestOptionsAllowDashSuccess(t *testing.T) {
+	var kennitala Kennitala = "010130-3019"
+	err := kennitala.IsValidKennitalaWithOptions(ValidationOptions{Types: KennitalaIndividual, AllowDash: true})
+	if err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestOptionsTrimSpaceSuccess(t *testing.T) {
+	var kennitala Kennitala = " 010130-3019\n"
+	err := kennitala.IsValidKennitalaWithOptions(ValidationOptions{Types: KennitalaIndividual, AllowDash: true, TrimSpace: true})
+	if err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestOptionsTrimSpaceFail(t *testing.T) {
+	var kennitala Kennitala = " 0101303019"
+	err := kennitala.IsValidKennitalaWithOptions(ValidationOptions{Types: KennitalaIndividual})
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaLength) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestOptionsTrimSpaceFileArtifactsSuccess(t *testing.T) {
+	opts := ValidationOptions{Types: KennitalaIndividual, TrimSpace: true}
+	for _, input := range []Kennitala{"0101303019\n", "0101303019\r\n", "\uFEFF0101303019", "\uFEFF0101303019\r\n"} {
+		if err := input.IsValidKennitalaWithOptions(opts); err != nil {
+			t.Errorf("Test Fail: %q", input)
+		}
+	}
+}
+
+func TestOptionsSystemLooseDate(t *testing.T) {
+	var kennitala Kennitala = "8999991189"
+	err := kennitala.IsValidKennitalaWithOptions(ValidationOptions{Types: KennitalaSystem, AllowSystemLooseDate: true})
+	if err != nil {
+		t.Errorf("Test Fail")
+	}
+	err = kennitala.IsValidKennitalaWithOptions(ValidationOptions{Types: KennitalaSystem})
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaDate) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestOptionsInvalidTypeFail(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	err := kennitala.IsValidKennitalaWithOptions(ValidationOptions{})
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaType) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestOptionsYearRangeSuccess(t *testing.T) {
+	opts := ValidationOptions{Types: KennitalaAllTypes, AllowSystemLooseDate: true, MinYear: 1900, MaxYear: 2000}
+	for _, kennitala := range []Kennitala{"0101303019", "0101002080", "6204830369", "8999991189"} {
+		if err := kennitala.IsValidKennitalaWithOptions(opts); err != nil {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestOptionsYearRangeFail(t *testing.T) {
+	opts := ValidationOptions{Types: KennitalaAllTypes, MinYear: 1900, MaxYear: 2000}
+	for _, kennitala := range []Kennitala{"0101303018", "3112992040"} {
+		if err := kennitala.IsValidKennitalaWithOptions(opts); !errors.Is(err, ErrBirthYearOutOfRange) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestNewValidatorYearRangeSuccess(t *testing.T) {
+	validate := NewValidator(KennitalaIndividual, WithYearRange(1950, 0))
+	if err := validate("0101303019"); !errors.Is(err, ErrBirthYearOutOfRange) {
+		t.Errorf("Test Fail")
+	}
+	if err := validate("3112992040"); err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+// legacyCheckDigit is Mod11CheckDigit but gives 0 where no check digit is
+// possible, as some older software did.
+func legacyCheckDigit(digits [8]int8) (int8, error) {
+	checkDigit, err := Mod11CheckDigit(digits)
+	if errors.Is(err, ErrImpossibleCheckDigit) {
+		return 0, nil
+	}
+	return checkDigit, err
+}
+
+func TestOptionsCheckDigitSuccess(t *testing.T) {
+	var kennitala Kennitala = "4111231209"
+	opts := defaultValidationOptions(KennitalaCompany)
+	if err := kennitala.IsValidKennitalaWithOptions(opts); !errors.Is(err, ErrImpossibleCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+
+	opts.CheckDigit = legacyCheckDigit
+	if err := kennitala.IsValidKennitalaWithOptions(opts); err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestNewValidatorCheckDigitFuncSuccess(t *testing.T) {
+	validate := NewValidator(KennitalaAllTypes, WithCheckDigitFunc(legacyCheckDigit))
+	if validate("4111231209") != nil || validate("0101303019") != nil {
+		t.Errorf("Test Fail")
+	}
+	if err := validate("0101303029"); !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestOptionsLegacyCenturySuccess(t *testing.T) {
+	// 0101303019 with the century digit 9 replaced by 5
+	var kennitala Kennitala = "0101303015"
+	if err := kennitala.IsValidKennitala(KennitalaIndividual); !errors.Is(err, ErrInvalidKennitalaCentury) {
+		t.Errorf("Test Fail")
+	}
+
+	validate := NewValidator(KennitalaIndividual, WithLegacyCentury(true))
+	if err := validate(kennitala); err != nil {
+		t.Errorf("Test Fail")
+	}
+	if err := validate("0101303018"); err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestOptionsLegacyCenturyFail(t *testing.T) {
+	validate := NewValidator(KennitalaIndividual, WithLegacyCentury(true), WithYearRange(1950, 0))
+	tests := map[Kennitala]error{
+		"2902002025": ErrInvalidKennitalaDate,       // 29 February 1900
+		"0101503015": ErrInvalidKennitalaCheckDigit, // wrong check digit
+		"0101303015": ErrBirthYearOutOfRange,        // read as 1930
+	}
+	for kennitala, expected := range tests {
+		if err := validate(kennitala); !errors.Is(err, expected) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestOptionsStrictCheckDigitSuccess(t *testing.T) {
+	strict := NewValidator(KennitalaCompany)
+	if err := strict("4111231209"); !errors.Is(err, ErrImpossibleCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+
+	lenient := NewValidator(KennitalaCompany, WithStrictCheckDigit(false))
+	if err := lenient("4111231209"); err != nil {
+		t.Errorf("Test Fail")
+	}
+	if err := lenient("4111231219"); !errors.Is(err, ErrInvalidKennitalaCheckDigit) || errors.Is(err, ErrImpossibleCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+	if err := lenient("6204830369"); err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestNewValidatorCountryPrefixSuccess(t *testing.T) {
+	validate := NewValidator(KennitalaIndividual, WithCountryPrefix())
+	if validate("IS0101303019") != nil || validate("IS010130-3019") != nil || validate("0101303019") != nil {
+		t.Errorf("Test Fail")
+	}
+	if err := NewValidator(KennitalaIndividual)("IS0101303019"); !errors.Is(err, ErrInvalidKennitalaLength) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestOptionsRejectFutureDatesSuccess(t *testing.T) {
+	validate := NewValidator(KennitalaAllTypes, WithRejectFutureDates(true))
+	for _, kennitala := range []Kennitala{"0101303019", "0101002080", "7112993000", "8101011059"} {
+		if err := validate(kennitala); err != nil {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+
+	var kennitala Kennitala = "3112992040" // 31 December 2099
+	if err := NewValidator(KennitalaAllTypes)(kennitala); err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestOptionsRejectFutureDatesFail(t *testing.T) {
+	validate := NewValidator(KennitalaIndividual, WithRejectFutureDates(true))
+	for _, kennitala := range []Kennitala{"3112992040", "1505992020"} {
+		if err := validate(kennitala); !errors.Is(err, ErrBirthdateInFuture) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestNewValidatorSuccess(t *testing.T) {
+	validate := NewValidator(KennitalaIndividual)
+	if validate("0101303019") != nil || validate("010130-3019") != nil {
+		t.Errorf("Test Fail")
+	}
+	if err := validate("6204830369"); !errors.Is(err, ErrInvalidKennitalaFirstLetter) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestNewValidatorOptionsSuccess(t *testing.T) {
+	validate := NewValidator(KennitalaAllTypes, WithAllowDash(false), WithTrimSpace(true), WithSystemLooseDate(false))
+	if validate(" 0101303019 ") != nil {
+		t.Errorf("Test Fail")
+	}
+	if err := validate("010130-3019"); !errors.Is(err, ErrInvalidKennitalaLength) {
+		t.Errorf("Test Fail")
+	}
+	if err := validate("8999991189"); !errors.Is(err, ErrInvalidKennitalaDate) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestNewValidatorInvalidTypeFail(t *testing.T) {
+	validate := NewValidator(0)
+	if err := validate("0101303019"); !errors.Is(err, ErrInvalidKennitalaType) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestNewValidatorMatchesOptionsSuccess(t *testing.T) {
+	inputs := append([]Kennitala{"", "0101303019", "010130-3019", "3112992040", "8999991189", "0101303018"}, ValidSamples...)
+	for _, sample := range InvalidSamples {
+		inputs = append(inputs, sample.K)
+	}
+
+	for _, opts := range [][]Option{
+		nil,
+		{WithYearRange(1900, 1999)},
+		{WithRejectFutureDates(true), WithSystemLooseDate(false)},
+	} {
+		for _, kennitalaType := range []KennitalaType{KennitalaIndividual, KennitalaCompany | KennitalaSystem} {
+			options := defaultValidationOptions(kennitalaType)
+			for _, opt := range opts {
+				opt(&options)
+			}
+			validate := NewValidator(kennitalaType, opts...)
+
+			for _, kennitala := range inputs {
+				if !reflect.DeepEqual(validate(kennitala), kennitala.IsValidKennitalaWithOptions(options)) {
+					t.Errorf("Test Fail: %s", kennitala)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkNewValidator(b *testing.B) {
+	var kennitala Kennitala = "0101303019"
+	validate := NewValidator(KennitalaAllTypes, WithTrimSpace(true))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = validate(kennitala)
+	}
+}
+
+// BenchmarkIsValidKennitalaWithOptions validates with the same options as
+// BenchmarkNewValidator, for comparison.
+func BenchmarkIsValidKennitalaWithOptions(b *testing.B) {
+	var kennitala Kennitala = "0101303019"
+	opts := defaultValidationOptions(KennitalaAllTypes)
+	opts.TrimSpace = true
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = kennitala.IsValidKennitalaWithOptions(opts)
+	}
+}
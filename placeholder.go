@@ -0,0 +1,38 @@
+package kennitala
+
+// PlaceholderPatterns are the kennitala IsLikelyPlaceholder recognizes, each
+// ten characters long where '?' matches any digit. The defaults are the
+// "gervimaður" test individuals used in the test environments of Ísland.is
+// and Registers Iceland, which are valid kennitala but belong to nobody. Only
+// exact numbers are listed, as any wider pattern would also match real
+// people. Append to the slice to recognize other placeholders.
+var PlaceholderPatterns = []string{
+	"0101302129",
+	"0101302209",
+	"0101302989",
+	"0101307789",
+}
+
+// IsLikelyPlaceholder reports whether the kennitala, once normalized, matches
+// one of PlaceholderPatterns, so imports can discard synthetic test records.
+func (kennitala Kennitala) IsLikelyPlaceholder() bool {
+	kennitala = kennitala.canonical()
+	for _, pattern := range PlaceholderPatterns {
+		if matchesPattern(kennitala, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesPattern(kennitala Kennitala, pattern string) bool {
+	if len(kennitala) != len(pattern) {
+		return false
+	}
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '?' && pattern[i] != kennitala[i] {
+			return false
+		}
+	}
+	return true
+}
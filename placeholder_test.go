@@ -0,0 +1,29 @@
+package kennitala
+
+import "testing"
+
+func TestIsLikelyPlaceholderSuccess(t *testing.T) {
+	for _, kennitala := range []Kennitala{"0101302989", "010130-2129", "0101307789", "0101302209"} {
+		if !kennitala.IsLikelyPlaceholder() {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestIsLikelyPlaceholderFail(t *testing.T) {
+	for _, kennitala := range []Kennitala{"0101303019", "6204830369", "", "010130298"} {
+		if kennitala.IsLikelyPlaceholder() {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+}
+
+func TestIsLikelyPlaceholderCustomPatternSuccess(t *testing.T) {
+	defer func(patterns []string) { PlaceholderPatterns = patterns }(PlaceholderPatterns)
+	PlaceholderPatterns = append(PlaceholderPatterns, "620483??6?")
+
+	var kennitala Kennitala = "6204830369"
+	if !kennitala.IsLikelyPlaceholder() {
+		t.Errorf("Test Fail")
+	}
+}
@@ -0,0 +1,36 @@
+package kennitala
+
+import "strings"
+
+// ReservedTestKennitalas holds the exact numbers in PlaceholderPatterns, the
+// "gervimaður" test individuals, as a denylist for IsValidKennitalaExcluding.
+// It is built when the package is initialized, so later changes to
+// PlaceholderPatterns are not reflected. Add to the map, or pass another, to
+// reject other reserved numbers.
+var ReservedTestKennitalas = exactPatterns(PlaceholderPatterns)
+
+// exactPatterns returns the patterns without wildcards as a set.
+func exactPatterns(patterns []string) map[Kennitala]struct{} {
+	set := make(map[Kennitala]struct{}, len(patterns))
+	for _, pattern := range patterns {
+		if !strings.Contains(pattern, "?") {
+			set[Kennitala(pattern)] = struct{}{}
+		}
+	}
+	return set
+}
+
+// IsValidKennitalaExcluding validates the kennitala like IsValidKennitala and
+// then returns ErrReservedKennitala if it is in deny, which is keyed by the
+// ten digit form without the dash. Test numbers are valid kennitala, so this
+// is how production systems can reject them.
+func (kennitala Kennitala) IsValidKennitalaExcluding(kennitalaType KennitalaType, deny map[Kennitala]struct{}) error {
+	if err := kennitala.IsValidKennitala(kennitalaType); err != nil {
+		return err
+	}
+
+	if _, ok := deny[undash(kennitala)]; ok {
+		return errReservedKennitala()
+	}
+	return nil
+}
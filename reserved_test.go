@@ -0,0 +1,49 @@
+package kennitala
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsValidKennitalaExcludingSuccess(t *testing.T) {
+	var kennitala Kennitala = "620483-0369" // Marel hf.
+	if err := kennitala.IsValidKennitalaExcluding(KennitalaCompany, ReservedTestKennitalas); err != nil {
+		t.Errorf("Test Fail")
+	}
+
+	kennitala = "0101302129"
+	if err := kennitala.IsValidKennitalaExcluding(KennitalaIndividual, nil); err != nil {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestIsValidKennitalaExcludingFail(t *testing.T) {
+	for _, kennitala := range []Kennitala{"0101302129", "010130-7789"} {
+		if err := kennitala.IsValidKennitalaExcluding(KennitalaIndividual, ReservedTestKennitalas); !errors.Is(err, ErrReservedKennitala) {
+			t.Errorf("Test Fail: %s", kennitala)
+		}
+	}
+
+	var kennitala Kennitala = "0101303029"
+	if err := kennitala.IsValidKennitalaExcluding(KennitalaIndividual, ReservedTestKennitalas); !errors.Is(err, ErrInvalidKennitalaCheckDigit) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestReservedTestKennitalasSuccess(t *testing.T) {
+	if len(ReservedTestKennitalas) != len(PlaceholderPatterns) {
+		t.Errorf("Test Fail")
+	}
+	for _, pattern := range PlaceholderPatterns {
+		if _, ok := ReservedTestKennitalas[Kennitala(pattern)]; !ok {
+			t.Errorf("Test Fail: %s", pattern)
+		}
+	}
+}
+
+func TestExactPatternsSkipsWildcardsSuccess(t *testing.T) {
+	set := exactPatterns([]string{"0101302129", "620483??6?"})
+	if _, ok := set["0101302129"]; len(set) != 1 || !ok {
+		t.Errorf("Test Fail")
+	}
+}
@@ -0,0 +1,36 @@
+package kennitala
+
+// ValidSamples are kennitala of every type that pass IsValidKennitala with
+// KennitalaAllTypes, for reuse in tests of code handling kennitala. They were
+// generated rather than taken from the register: the individuals and
+// companies are dated in the 1800s or in 2099, so they cannot belong to
+// anyone alive.
+var ValidSamples = []Kennitala{
+	"0101303018", // individual, 1 January 1830
+	"0101502058", // individual, 1 January 1850
+	"2902963078", // individual, 29 February 1896
+	"1505992020", // individual, 15 May 2099
+	"4101992030", // company, 1 January 2099
+	"7112993000", // company, 31 December 2099
+	"8101011059", // kerfiskennitala
+	"9101011029", // kerfiskennitala
+}
+
+// InvalidSamples are kennitala that fail IsValidKennitala with
+// KennitalaAllTypes, each with the sentinel error it fails with.
+var InvalidSamples = []struct {
+	K   Kennitala
+	Err error
+}{
+	{"", ErrInvalidKennitalaLength},
+	{"010130301", ErrInvalidKennitalaLength},
+	{"010130-30199", ErrInvalidKennitalaLength},
+	{"01013030X9", ErrInvalidKennitalaNonNumeric},
+	{"0000000000", ErrInvalidKennitalaFormat},
+	{"0101303011", ErrInvalidKennitalaCentury},
+	{"0113303018", ErrInvalidKennitalaMonth},
+	{"3102303018", ErrInvalidKennitalaDay},
+	{"2902002029", ErrInvalidKennitalaDay},
+	{"0101303028", ErrInvalidKennitalaCheckDigit},
+	{"4111231209", ErrImpossibleCheckDigit},
+}
@@ -0,0 +1,22 @@
+package kennitala
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidSamplesSuccess(t *testing.T) {
+	for _, kennitala := range ValidSamples {
+		if err := kennitala.IsValidKennitala(KennitalaAllTypes); err != nil {
+			t.Errorf("Test Fail: %s: %s", kennitala, err)
+		}
+	}
+}
+
+func TestInvalidSamplesFail(t *testing.T) {
+	for _, sample := range InvalidSamples {
+		if err := sample.K.IsValidKennitala(KennitalaAllTypes); !errors.Is(err, sample.Err) {
+			t.Errorf("Test Fail: %s: %v", sample.K, err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package kennitala
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSortByBirthdateSuccess(t *testing.T) {
+	ks := []Kennitala{
+		"invalid",
+		"0101002080", // 2000-01-01
+		"0101303019", // 1930-01-01, serial 30
+		"8101011059", // kerfiskennitala
+		"6204830369", // 1983-04-22
+		"0101301079", // 1930-01-01, serial 10
+		"0101303018", // 1830-01-01
+		"",
+	}
+	SortByBirthdate(ks)
+
+	expected := []Kennitala{
+		"0101303018",
+		"0101301079",
+		"0101303019",
+		"6204830369",
+		"0101002080",
+		"",
+		"8101011059",
+		"invalid",
+	}
+	if !reflect.DeepEqual(ks, expected) {
+		t.Errorf("Test Fail: %v", ks)
+	}
+}
+
+func TestSortByBirthdateEmptySuccess(t *testing.T) {
+	SortByBirthdate(nil)
+}
+
+func TestLessSuccess(t *testing.T) {
+	var older, younger Kennitala = "0101303018", "0101303019"
+	if !older.Less(younger) || younger.Less(older) || older.Less(older) {
+		t.Errorf("Test Fail")
+	}
+
+	var invalid Kennitala = "0101303029"
+	if invalid.Less(younger) || !younger.Less(invalid) {
+		t.Errorf("Test Fail")
+	}
+
+	// Invalid entries sort last even with an earlier date
+	invalid = "0101303028" // 1830-01-01, wrong check digit
+	if invalid.Less(younger) || !younger.Less(invalid) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestSortByBirthdateInvalidLastSuccess(t *testing.T) {
+	ks := []Kennitala{"0101002080", "010130-3019", "0101303029"}
+	SortByBirthdate(ks)
+
+	expected := []Kennitala{"010130-3019", "0101002080", "0101303029"}
+	if !reflect.DeepEqual(ks, expected) {
+		t.Errorf("Test Fail: %v", ks)
+	}
+}
+
+func TestKennitalaSliceSuccess(t *testing.T) {
+	ks := KennitalaSlice{"invalid", "0101002080", "8101011059", "6204830369", "0101303019", "0101301079"}
+	sort.Sort(ks)
+
+	expected := KennitalaSlice{"0101301079", "0101303019", "6204830369", "0101002080", "8101011059", "invalid"}
+	if !reflect.DeepEqual(ks, expected) {
+		t.Errorf("Test Fail: %v", ks)
+	}
+}
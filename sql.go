@@ -0,0 +1,48 @@
+package kennitala
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// Scan implements sql.Scanner. It accepts string and []byte values and
+// normalizes them, but does not validate so legacy rows can still be read;
+// values that cannot be normalized are kept with surrounding whitespace
+// trimmed. Call IsValidKennitala on the result to validate it. A NULL column
+// scans to the empty Kennitala.
+func (kennitala *Kennitala) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		*kennitala = ""
+	case string:
+		*kennitala = normalizeOrTrim(value)
+	case []byte:
+		*kennitala = normalizeOrTrim(string(value))
+	default:
+		return fmt.Errorf("kennitala: cannot scan %T: %w", src, errInvalidKennitalaScanType())
+	}
+	return nil
+}
+
+func normalizeOrTrim(value string) Kennitala {
+	if normalized, err := Normalize(value); err == nil {
+		return normalized
+	}
+	return Kennitala(strings.TrimSpace(value))
+}
+
+// Value implements driver.Valuer, storing the canonical ten digit form so it
+// fits the column GormDataType declares. An empty Kennitala is stored as
+// NULL.
+func (kennitala Kennitala) Value() (driver.Value, error) {
+	if kennitala.IsEmpty() {
+		return nil, nil
+	}
+	return string(kennitala.canonical()), nil
+}
+
+// GormDataType declares the column type GORM uses for Kennitala fields.
+func (kennitala Kennitala) GormDataType() string {
+	return "varchar(10)"
+}
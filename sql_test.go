@@ -0,0 +1,150 @@
+package kennitala
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+)
+
+// fakeDriver stores the last inserted value and returns it from any query,
+// as []byte to mimic drivers that return text columns as bytes.
+type fakeDriver struct{ value driver.Value }
+
+type fakeConn struct{ driver *fakeDriver }
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+type fakeRows struct {
+	value driver.Value
+	done  bool
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) { return &fakeConn{driver: d}, nil }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, query: query}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.driver.value = args[0]
+	if value, ok := args[0].(string); ok {
+		s.conn.driver.value = []byte(value)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{value: s.conn.driver.value}, nil
+}
+
+func (r *fakeRows) Columns() []string { return []string{"kennitala"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.value
+	return nil
+}
+
+func init() {
+	sql.Register("kennitalafake", &fakeDriver{})
+}
+
+func roundTrip(t *testing.T, kennitala Kennitala) Kennitala {
+	db, err := sql.Open("kennitalafake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec("INSERT", kennitala); err != nil {
+		t.Fatal(err)
+	}
+
+	var scanned Kennitala
+	if err := db.QueryRow("SELECT").Scan(&scanned); err != nil {
+		t.Fatal(err)
+	}
+	return scanned
+}
+
+func TestSQLRoundTripSuccess(t *testing.T) {
+	var kennitala Kennitala = "0101303019"
+	if roundTrip(t, kennitala) != kennitala {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestSQLRoundTripDashedSuccess(t *testing.T) {
+	var kennitala Kennitala = "010130-3019"
+	value, err := kennitala.Value()
+	if err != nil || value != "0101303019" || roundTrip(t, kennitala) != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestSQLRoundTripNullSuccess(t *testing.T) {
+	var kennitala Kennitala = ""
+	if roundTrip(t, kennitala) != kennitala {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestSQLRoundTripTrimsSuccess(t *testing.T) {
+	var kennitala Kennitala = " 0101303019\n"
+	if roundTrip(t, kennitala) != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestScanUnsupportedTypeFail(t *testing.T) {
+	var kennitala Kennitala
+	err := kennitala.Scan(101303019)
+	if err == nil || !errors.Is(err, ErrInvalidKennitalaScanType) {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestScanDoesNotValidateSuccess(t *testing.T) {
+	var kennitala Kennitala
+	err := kennitala.Scan([]byte("legacy"))
+	if err != nil || kennitala != "legacy" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestScanNormalizesSuccess(t *testing.T) {
+	var kennitala Kennitala
+	err := kennitala.Scan("010130-3019")
+	if err != nil || kennitala != "0101303019" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestGormDataTypeSuccess(t *testing.T) {
+	var kennitala Kennitala
+	if kennitala.GormDataType() != "varchar(10)" {
+		t.Errorf("Test Fail")
+	}
+}
+
+func TestSQLRoundTripWhitespaceSuccess(t *testing.T) {
+	var kennitala Kennitala = "  "
+	value, err := kennitala.Value()
+	if err != nil || value != nil || roundTrip(t, kennitala) != "" {
+		t.Errorf("Test Fail")
+	}
+}
@@ -0,0 +1,29 @@
+package kennitala
+
+// SuggestCorrections returns the valid kennitala that the kennitala becomes
+// by swapping two adjacent digits among the first eight, the most common
+// typing mistake, for "did you mean" prompts. The check and century digits
+// are kept as typed. Only single swaps are tried, so at most seven
+// suggestions are returned, and none for a kennitala that is already valid
+// or is not ten digits once normalized.
+func (kennitala Kennitala) SuggestCorrections() []Kennitala {
+	kennitala = kennitala.canonical()
+	if kennitala.HasValidStructure() != nil || kennitala.IsValidKennitala(KennitalaAllTypes) == nil {
+		return nil
+	}
+
+	var suggestions []Kennitala
+	digits := []byte(kennitala)
+	for i := 0; i < 7; i++ {
+		if digits[i] == digits[i+1] {
+			continue
+		}
+
+		digits[i], digits[i+1] = digits[i+1], digits[i]
+		if candidate := Kennitala(digits); candidate.IsValidKennitala(KennitalaAllTypes) == nil {
+			suggestions = append(suggestions, candidate)
+		}
+		digits[i], digits[i+1] = digits[i+1], digits[i]
+	}
+	return suggestions
+}
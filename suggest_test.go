@@ -0,0 +1,39 @@
+package kennitala
+
+import "testing"
+
+func TestSuggestCorrectionsSuccess(t *testing.T) {
+	// 0101303019 with the fifth and sixth digits swapped
+	var kennitala Kennitala = "010103-3019"
+	suggestions := kennitala.SuggestCorrections()
+
+	found := false
+	for _, suggestion := range suggestions {
+		if suggestion.IsValidKennitala(KennitalaAllTypes) != nil {
+			t.Errorf("Test Fail: %s", suggestion)
+		}
+		found = found || suggestion == "0101303019"
+	}
+	if !found || len(suggestions) > 7 {
+		t.Errorf("Test Fail: %v", suggestions)
+	}
+}
+
+func TestSuggestCorrectionsCompanySuccess(t *testing.T) {
+	// 6204830369 with the seventh and eighth digits swapped
+	var kennitala Kennitala = "6204833069"
+	for _, suggestion := range kennitala.SuggestCorrections() {
+		if suggestion == "6204830369" {
+			return
+		}
+	}
+	t.Errorf("Test Fail")
+}
+
+func TestSuggestCorrectionsNoneSuccess(t *testing.T) {
+	for _, kennitala := range []Kennitala{"0101303019", "0101303", "abcdefghij", ""} {
+		if suggestions := kennitala.SuggestCorrections(); suggestions != nil {
+			t.Errorf("Test Fail: %s: %v", kennitala, suggestions)
+		}
+	}
+}
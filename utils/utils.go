@@ -9,3 +9,16 @@ func StringToInt(input string) (int8, error) {
 	}
 	return int8(intVar), nil
 }
+
+// DigitAt returns the value of the ASCII digit at index i of s. The ok flag
+// is false when i is out of range or the byte there is not a digit.
+func DigitAt(s string, i int) (int8, bool) {
+	if i < 0 || i >= len(s) {
+		return 0, false
+	}
+	c := s[i]
+	if c < '0' || c > '9' {
+		return 0, false
+	}
+	return int8(c - '0'), true
+}
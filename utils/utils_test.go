@@ -0,0 +1,20 @@
+package utils
+
+import "testing"
+
+func TestDigitAtSuccess(t *testing.T) {
+	for i, expected := range []int8{0, 1, 2, 3, 4, 5, 6, 7, 8, 9} {
+		digit, ok := DigitAt("0123456789", i)
+		if !ok || digit != expected {
+			t.Errorf("Test Fail: %d", i)
+		}
+	}
+}
+
+func TestDigitAtFail(t *testing.T) {
+	for _, i := range []int{-1, 0, 1, 2, 3, 4} {
+		if _, ok := DigitAt("a+- ", i); ok {
+			t.Errorf("Test Fail: %d", i)
+		}
+	}
+}